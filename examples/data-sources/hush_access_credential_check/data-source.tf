@@ -0,0 +1,39 @@
+# Test the admin connection of a Postgres credential from its deployment
+data "hush_access_credential_check" "orders_db" {
+  access_credential_id = hush_postgres_access_credential.orders.id
+}
+
+# Refuse to grant access through a credential that cannot mint users
+resource "hush_access_policy" "orders" {
+  name                 = "orders-db-policy"
+  access_credential_id = hush_postgres_access_credential.orders.id
+  access_privilege_ids = [hush_postgres_access_privilege.orders.id]
+  deployment_ids       = [hush_deployment.example.id]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "orders"
+  }
+
+  env_delivery_config {
+    name = "DATABASE_URL"
+    type = "template"
+    key  = "postgresql://$${username}:$${password}@$${host}:$${port}/$${db}"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.hush_access_credential_check.orders_db.reachable
+      error_message = "Database unreachable from the access bridge: ${data.hush_access_credential_check.orders_db.detail}"
+    }
+
+    precondition {
+      condition     = data.hush_access_credential_check.orders_db.auth_ok && data.hush_access_credential_check.orders_db.can_create_user
+      error_message = "The admin account cannot create users: ${data.hush_access_credential_check.orders_db.detail}"
+    }
+  }
+}
+
+output "latency_ms" {
+  value = data.hush_access_credential_check.orders_db.latency_ms
+}
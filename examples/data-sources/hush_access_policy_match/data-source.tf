@@ -0,0 +1,21 @@
+data "hush_access_policy_match" "api" {
+  deployment_id   = hush_deployment.example.id
+  namespace       = "production"
+  service_account = "api"
+  container       = "server"
+
+  pod_labels = {
+    app = "api"
+  }
+}
+
+output "matching_policy_ids" {
+  value = data.hush_access_policy_match.api.policies[*].id
+}
+
+output "delivered_env_vars" {
+  value = [
+    for item in flatten(data.hush_access_policy_match.api.policies[*].delivery_item) :
+    item.target if item.delivery_type == "env"
+  ]
+}
@@ -0,0 +1,11 @@
+data "hush_anthropic_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_anthropic_access_credential.example.name
+}
+
+output "workspace_id" {
+  value = data.hush_anthropic_access_credential.example.workspace_id
+}
@@ -0,0 +1,15 @@
+data "hush_anthropic_access_privilege" "example" {
+  id = "apr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_anthropic_access_privilege.example.name
+}
+
+output "permission_type" {
+  value = data.hush_anthropic_access_privilege.example.permission_type
+}
+
+output "monthly_spend_limit_usd" {
+  value = data.hush_anthropic_access_privilege.example.monthly_spend_limit_usd
+}
@@ -0,0 +1,11 @@
+data "hush_azure_openai_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_azure_openai_access_credential.example.name
+}
+
+output "account_name" {
+  value = data.hush_azure_openai_access_credential.example.account_name
+}
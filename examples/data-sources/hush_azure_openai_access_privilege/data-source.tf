@@ -0,0 +1,15 @@
+data "hush_azure_openai_access_privilege" "example" {
+  id = "apr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_azure_openai_access_privilege.example.name
+}
+
+output "deployments" {
+  value = data.hush_azure_openai_access_privilege.example.deployments
+}
+
+output "tokens_per_minute" {
+  value = data.hush_azure_openai_access_privilege.example.tokens_per_minute
+}
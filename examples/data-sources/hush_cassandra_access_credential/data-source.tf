@@ -0,0 +1,19 @@
+data "hush_cassandra_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_cassandra_access_credential.example.name
+}
+
+output "engine" {
+  value = data.hush_cassandra_access_credential.example.engine
+}
+
+output "hosts" {
+  value = data.hush_cassandra_access_credential.example.hosts
+}
+
+output "region" {
+  value = data.hush_cassandra_access_credential.example.region
+}
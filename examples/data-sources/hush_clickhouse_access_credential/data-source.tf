@@ -0,0 +1,19 @@
+data "hush_clickhouse_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_clickhouse_access_credential.example.name
+}
+
+output "engine" {
+  value = data.hush_clickhouse_access_credential.example.engine
+}
+
+output "host" {
+  value = data.hush_clickhouse_access_credential.example.host
+}
+
+output "cluster" {
+  value = data.hush_clickhouse_access_credential.example.cluster
+}
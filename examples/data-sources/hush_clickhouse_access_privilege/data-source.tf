@@ -0,0 +1,19 @@
+data "hush_clickhouse_access_privilege" "example" {
+  id = "apr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_clickhouse_access_privilege.example.name
+}
+
+output "grants" {
+  value = data.hush_clickhouse_access_privilege.example.grants
+}
+
+output "settings_profile" {
+  value = data.hush_clickhouse_access_privilege.example.settings_profile
+}
+
+output "row_policies" {
+  value = data.hush_clickhouse_access_privilege.example.row_policies
+}
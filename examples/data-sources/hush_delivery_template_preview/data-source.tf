@@ -0,0 +1,19 @@
+data "hush_delivery_template_preview" "database_url" {
+  template = "postgresql://$${username}:$${password}@$${host}:$${port}/$${db}"
+
+  values = {
+    username = "app"
+    password = "example"
+    host     = "db.internal"
+    port     = "5432"
+    db       = "orders"
+  }
+}
+
+output "rendered" {
+  value = data.hush_delivery_template_preview.database_url.rendered
+}
+
+output "keys" {
+  value = data.hush_delivery_template_preview.database_url.keys
+}
@@ -0,0 +1,23 @@
+data "hush_mssql_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_mssql_access_credential.example.name
+}
+
+output "host" {
+  value = data.hush_mssql_access_credential.example.host
+}
+
+output "instance" {
+  value = data.hush_mssql_access_credential.example.instance
+}
+
+output "db_name" {
+  value = data.hush_mssql_access_credential.example.db_name
+}
+
+output "auth_method" {
+  value = data.hush_mssql_access_credential.example.auth_method
+}
@@ -0,0 +1,23 @@
+data "hush_oracle_access_credential" "example" {
+  id = "acr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_oracle_access_credential.example.name
+}
+
+output "host" {
+  value = data.hush_oracle_access_credential.example.host
+}
+
+output "port" {
+  value = data.hush_oracle_access_credential.example.port
+}
+
+output "service_name" {
+  value = data.hush_oracle_access_credential.example.service_name
+}
+
+output "tls" {
+  value = data.hush_oracle_access_credential.example.tls
+}
@@ -0,0 +1,19 @@
+data "hush_oracle_access_privilege" "example" {
+  id = "apr-eu12345678"
+}
+
+output "name" {
+  value = data.hush_oracle_access_privilege.example.name
+}
+
+output "system_privileges" {
+  value = data.hush_oracle_access_privilege.example.system_privileges
+}
+
+output "object_grants" {
+  value = data.hush_oracle_access_privilege.example.object_grants
+}
+
+output "roles" {
+  value = data.hush_oracle_access_privilege.example.roles
+}
@@ -0,0 +1,17 @@
+variable "release" {
+  description = "The CI release identifier; a new value rotates the credential"
+  type        = string
+}
+
+# Rotate the OpenAI credential once per release
+resource "hush_access_credential_rotation" "openai" {
+  access_credential_id = hush_openai_access_credential.example.id
+
+  triggers = {
+    release = var.release
+  }
+}
+
+output "rotated_at" {
+  value = hush_access_credential_rotation.openai.rotated_at
+}
@@ -157,6 +157,41 @@ resource "hush_access_policy" "volume_example" {
   }
 }
 
+# Create an access policy with volume delivery readable only by the app user
+resource "hush_access_policy" "volume_permissions_example" {
+  name                 = "prod-volume-permissions-policy"
+  description          = "Access policy with file mode and ownership on mounted files"
+  enabled              = true
+  access_credential_id = hush_postgres_access_credential.example.id
+  access_privilege_ids = [hush_postgres_access_privilege.example.id]
+  deployment_ids       = [hush_deployment.example.id]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "production"
+  }
+
+  volume_delivery_config {
+    mount_point = "/etc/secrets"
+
+    item {
+      path = "db_password"
+      key  = "password"
+      mode = "0400"
+      uid  = 1000
+      gid  = 1000
+    }
+
+    # The whole credential as a single dotenv file
+    render_as {
+      path   = "database.env"
+      format = "env"
+      mode   = "0440"
+      gid    = 1000
+    }
+  }
+}
+
 # Create an access policy with volume delivery using templates
 resource "hush_access_policy" "volume_template_example" {
   name                 = "prod-volume-template-policy"
@@ -319,3 +354,62 @@ resource "hush_access_policy" "sdk_example" {
     }
   }
 }
+
+# Create an access policy delivering one credential both as environment
+# variables and as mounted files
+resource "hush_access_policy" "combined_delivery_example" {
+  name                 = "prod-combined-delivery-policy"
+  description          = "Access policy with env and volume delivery"
+  enabled              = true
+  access_credential_id = hush_postgres_access_credential.example.id
+  access_privilege_ids = [hush_postgres_access_privilege.example.id]
+  deployment_ids       = [hush_deployment.example.id]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "production"
+  }
+
+  env_delivery_config {
+    name = "DB_USERNAME"
+    key  = "username"
+  }
+
+  volume_delivery_config {
+    mount_point = "/etc/secrets"
+
+    item {
+      path = "db_password"
+      key  = "password"
+    }
+  }
+}
+
+# Create a break-glass access policy in force only for a fixed window, and
+# within it only during working hours
+resource "hush_access_policy" "break_glass_example" {
+  name                 = "break-glass-db-policy"
+  description          = "Temporary contractor access to the production database"
+  enabled              = true
+  access_credential_id = hush_postgres_access_credential.example.id
+  access_privilege_ids = [hush_postgres_access_privilege.example.id]
+  deployment_ids       = [hush_deployment.example.id]
+
+  not_before = "2026-11-02T09:00:00Z"
+  expires_at = "2026-11-06T18:00:00Z"
+
+  schedule {
+    cron     = "* 9-17 * * 1-5"
+    timezone = "Europe/London"
+  }
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "production"
+  }
+
+  env_delivery_config {
+    name = "DB_PASSWORD"
+    key  = "password"
+  }
+}
@@ -0,0 +1,28 @@
+# The policy is defined once, centrally, and ignores deployment_ids so the
+# attachments below are not undone on the next apply.
+resource "hush_access_policy" "shared" {
+  name                 = "shared-database-policy"
+  access_credential_id = hush_postgres_access_credential.example.id
+  access_privilege_ids = [hush_postgres_access_privilege.example.id]
+  deployment_ids       = [hush_deployment.central.id]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "production"
+  }
+
+  env_delivery_config {
+    name = "DB_PASSWORD"
+    key  = "password"
+  }
+
+  lifecycle {
+    ignore_changes = [deployment_ids]
+  }
+}
+
+# Each cluster module enrolls its own deployment
+resource "hush_access_policy_deployment_attachment" "cluster" {
+  access_policy_id = hush_access_policy.shared.id
+  deployment_id    = hush_deployment.cluster.id
+}
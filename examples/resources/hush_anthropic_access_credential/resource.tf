@@ -0,0 +1,10 @@
+# Create an Anthropic dynamic access credential
+resource "hush_anthropic_access_credential" "example" {
+  name                     = "prod-anthropic"
+  description              = "Production Anthropic API credential"
+  deployment_ids           = [hush_deployment.example.id]
+  admin_api_key_wo         = var.anthropic_admin_api_key
+  admin_api_key_wo_version = "1"
+  organization_id          = "7c1f3d2a-5b6e-4f80-9a1b-2c3d4e5f6a7b"
+  workspace_id             = "wrkspc_01AbCdEfGhIjKlMn"
+}
@@ -0,0 +1,30 @@
+# Create an Anthropic access privilege with spend and rate limits
+resource "hush_anthropic_access_privilege" "example" {
+  name                    = "ai-platform-developer"
+  description             = "Developer keys in the AI platform workspace"
+  permission_type         = "Developer"
+  workspace_id            = "wrkspc_01AbCdEfGhIjKlMn"
+  monthly_spend_limit_usd = 500
+
+  rate_limits {
+    requests_per_minute = 50
+  }
+
+  rate_limits {
+    model                    = "claude-sonnet-4-5"
+    input_tokens_per_minute  = 40000
+    output_tokens_per_minute = 8000
+  }
+}
+
+# Create an Anthropic access privilege limited to specific APIs
+resource "hush_anthropic_access_privilege" "restricted" {
+  name            = "messages-only"
+  description     = "Messages API access only"
+  permission_type = "Restricted"
+
+  permissions {
+    name  = "messages"
+    level = "write"
+  }
+}
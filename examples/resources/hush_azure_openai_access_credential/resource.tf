@@ -0,0 +1,24 @@
+# Create an Azure OpenAI dynamic access credential using an app registration
+resource "hush_azure_openai_access_credential" "example" {
+  name                     = "prod-azure-openai"
+  description              = "Production Azure OpenAI credential"
+  deployment_ids           = [hush_deployment.example.id]
+  tenant_id                = "00000000-0000-0000-0000-000000000000"
+  subscription_id          = "11111111-1111-1111-1111-111111111111"
+  resource_group           = "ai-prod-rg"
+  account_name             = "ai-prod-openai"
+  client_id                = "22222222-2222-2222-2222-222222222222"
+  client_secret_wo         = var.azure_client_secret
+  client_secret_wo_version = "1"
+}
+
+# Create an Azure OpenAI dynamic access credential using the access-manager's
+# default Azure credentials (managed identity / workload identity)
+resource "hush_azure_openai_access_credential" "default_identity" {
+  name            = "prod-azure-openai-mi"
+  deployment_ids  = [hush_deployment.example.id]
+  tenant_id       = "00000000-0000-0000-0000-000000000000"
+  subscription_id = "11111111-1111-1111-1111-111111111111"
+  resource_group  = "ai-prod-rg"
+  account_name    = "ai-prod-openai"
+}
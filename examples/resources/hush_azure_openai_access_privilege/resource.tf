@@ -0,0 +1,7 @@
+# Create an Azure OpenAI access privilege scoped to two model deployments
+resource "hush_azure_openai_access_privilege" "example" {
+  name              = "chat-and-embeddings"
+  description       = "GPT-4o and embeddings with a per-user quota"
+  deployments       = ["gpt-4o-prod", "text-embedding-3-large"]
+  tokens_per_minute = 30000
+}
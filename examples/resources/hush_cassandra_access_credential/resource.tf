@@ -0,0 +1,33 @@
+# Create a dynamic access credential for an Apache Cassandra cluster
+resource "hush_cassandra_access_credential" "cassandra" {
+  name             = "prod-cassandra"
+  description      = "Production Cassandra cluster credential"
+  deployment_ids   = [hush_deployment.example.id]
+  engine           = "cassandra"
+  hosts            = ["cass-1.internal.example.com", "cass-2.internal.example.com"]
+  local_datacenter = "dc1"
+  tls              = true
+  username         = "hush_admin"
+  password_wo      = var.cassandra_password
+}
+
+# Create a dynamic access credential for a ScyllaDB cluster
+resource "hush_cassandra_access_credential" "scylla" {
+  name           = "prod-scylla"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "scylla"
+  hosts          = ["scylla.internal.example.com"]
+  username       = "hush_admin"
+  password_wo    = var.scylla_password
+}
+
+# Create a dynamic access credential for Amazon Keyspaces. Omit access_key_id
+# and secret_access_key_wo to use AWS workload identity federation.
+resource "hush_cassandra_access_credential" "keyspaces" {
+  name                 = "prod-keyspaces"
+  deployment_ids       = [hush_deployment.example.id]
+  engine               = "keyspaces"
+  region               = "us-east-1"
+  access_key_id        = var.aws_access_key_id
+  secret_access_key_wo = var.aws_secret_access_key
+}
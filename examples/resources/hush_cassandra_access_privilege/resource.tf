@@ -0,0 +1,16 @@
+# Create a Cassandra access privilege
+resource "hush_cassandra_access_privilege" "example" {
+  name        = "orders-writer"
+  description = "Read the orders keyspace and write its line items"
+
+  grants {
+    permissions = ["SELECT"]
+    keyspace    = "orders"
+  }
+
+  grants {
+    permissions = ["MODIFY"]
+    keyspace    = "orders"
+    table       = "line_items"
+  }
+}
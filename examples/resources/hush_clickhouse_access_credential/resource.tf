@@ -0,0 +1,25 @@
+# Create a ClickHouse dynamic access credential for a self-managed (native) server
+resource "hush_clickhouse_access_credential" "native" {
+  name           = "prod-clickhouse"
+  description    = "Production ClickHouse cluster credential"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "native"
+  host           = "clickhouse.internal.example.com"
+  port           = 9440
+  tls            = true
+  cluster        = "analytics"
+  username       = "hush_admin"
+  password_wo    = var.clickhouse_password
+}
+
+# Create a ClickHouse dynamic access credential for a ClickHouse Cloud service
+resource "hush_clickhouse_access_credential" "cloud" {
+  name            = "prod-clickhouse-cloud"
+  description     = "Production ClickHouse Cloud credential"
+  deployment_ids  = [hush_deployment.example.id]
+  engine          = "clickhouse_cloud"
+  organization_id = "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e"
+  service_id      = "6d4f2a3b-1c9e-4b7a-9f2d-0e8c5a7b3d1f"
+  key_id          = var.clickhouse_cloud_key_id
+  key_secret_wo   = var.clickhouse_cloud_key_secret
+}
@@ -0,0 +1,23 @@
+# Create a ClickHouse access privilege
+resource "hush_clickhouse_access_privilege" "example" {
+  name             = "events-analyst"
+  description      = "Read access to the events database for one tenant"
+  settings_profile = "analyst"
+
+  grants {
+    privileges = ["SELECT"]
+    database   = "events"
+    tables     = ["page_views", "sessions"]
+  }
+
+  grants {
+    privileges = ["SHOW TABLES"]
+    database   = "events"
+  }
+
+  row_policies {
+    database  = "events"
+    table     = "page_views"
+    condition = "tenant_id = 42"
+  }
+}
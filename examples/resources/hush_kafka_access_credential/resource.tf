@@ -21,3 +21,25 @@ resource "hush_kafka_access_credential" "aiven" {
   service_name   = "my-kafka-service"
   token_wo       = var.aiven_token
 }
+
+# Create a Kafka dynamic access credential for a Confluent Cloud cluster
+resource "hush_kafka_access_credential" "confluent" {
+  name                        = "prod-kafka-confluent"
+  deployment_ids              = [hush_deployment.example.id]
+  engine                      = "confluent_cloud"
+  environment_id              = "env-abc123"
+  cluster_id                  = "lkc-xyz789"
+  cloud_api_key               = var.confluent_cloud_api_key
+  cloud_api_secret_wo         = var.confluent_cloud_api_secret
+  cloud_api_secret_wo_version = "1"
+}
+
+# Create a Kafka dynamic access credential for an Amazon MSK cluster with IAM access control
+resource "hush_kafka_access_credential" "msk" {
+  name           = "prod-kafka-msk"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "msk_iam"
+  cluster_arn    = "arn:aws:kafka:us-east-1:123456789012:cluster/orders/0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-1"
+  region         = "us-east-1"
+  role_arn       = "arn:aws:iam::123456789012:role/hush-msk"
+}
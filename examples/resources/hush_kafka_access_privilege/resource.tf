@@ -20,3 +20,17 @@ resource "hush_kafka_access_privilege" "example" {
     host            = "*"
   }
 }
+
+# Grant produce access on Confluent Cloud through RBAC role bindings
+resource "hush_kafka_access_privilege" "confluent_producer" {
+  name                    = "orders-producer"
+  confluent_authorization = "rbac"
+
+  acls {
+    resource_type   = "Topic"
+    resource_name   = "orders."
+    pattern_type    = "PREFIXED"
+    operation       = "Write"
+    permission_type = "ALLOW"
+  }
+}
@@ -25,6 +25,16 @@ resource "hush_kv_access_credential" "example" {
   }
 }
 
+# Load the items from a YAML file. items_wo keeps the values out of state;
+# bump items_wo_version to resend every item.
+resource "hush_kv_access_credential" "from_file" {
+  name           = "example-app-settings"
+  deployment_ids = ["dep-example123456789"]
+
+  items_wo         = jsonencode(yamldecode(file("${path.module}/settings.yaml")))
+  items_wo_version = "1"
+}
+
 # Output the credential information
 output "credential_id" {
   value = hush_kv_access_credential.example.id
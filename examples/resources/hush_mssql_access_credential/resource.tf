@@ -0,0 +1,27 @@
+# Create a SQL Server dynamic access credential with SQL authentication
+resource "hush_mssql_access_credential" "example" {
+  name                = "prod-sqlserver"
+  description         = "Production SQL Server database credential"
+  deployment_ids      = [hush_deployment.example.id]
+  db_name             = "orders"
+  host                = "sql01.example.com"
+  instance            = "PROD"
+  username            = "hush_admin"
+  password_wo         = var.sqlserver_password
+  password_wo_version = "1"
+}
+
+# Create an Azure SQL dynamic access credential that authenticates as a
+# Microsoft Entra ID service principal
+resource "hush_mssql_access_credential" "azure_sql" {
+  name                = "prod-azure-sql"
+  deployment_ids      = [hush_deployment.example.id]
+  db_name             = "orders"
+  host                = "orders.database.windows.net"
+  encrypt             = "strict"
+  auth_method         = "entra_id"
+  tenant_id           = var.azure_tenant_id
+  username            = var.hush_sp_client_id
+  password_wo         = var.hush_sp_client_secret
+  password_wo_version = "1"
+}
@@ -0,0 +1,19 @@
+# Create a SQL Server access privilege
+resource "hush_mssql_access_privilege" "example" {
+  name        = "orders-read-write"
+  description = "Read/write access to the sales schema"
+
+  grants {
+    privileges    = ["SELECT", "INSERT", "UPDATE"]
+    object_type   = "TABLE"
+    schema_name   = "sales"
+    all_in_schema = true
+  }
+
+  grants {
+    privileges   = ["EXECUTE"]
+    object_type  = "PROCEDURE"
+    schema_name  = "sales"
+    object_names = ["usp_place_order"]
+  }
+}
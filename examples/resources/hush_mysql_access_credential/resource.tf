@@ -10,3 +10,16 @@ resource "hush_mysql_access_credential" "example" {
   username       = "app_user"
   password_wo    = var.mysql_password
 }
+
+# Authenticate to Amazon RDS with IAM instead of a password. With no AWS keys,
+# Hush signs authentication tokens with its workload identity.
+resource "hush_mysql_access_credential" "rds_iam" {
+  name           = "prod-mysql-rds"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "rds_iam"
+  db_name        = "mydb"
+  host           = "mydb.abc123.us-east-1.rds.amazonaws.com"
+  ssl_mode       = "verify_identity"
+  username       = "hush_admin"
+  region         = "us-east-1"
+}
@@ -0,0 +1,26 @@
+# Create an Oracle Database dynamic access credential for a pluggable database
+resource "hush_oracle_access_credential" "example" {
+  name                = "finance-oracle"
+  description         = "Finance platform Oracle database credential"
+  deployment_ids      = [hush_deployment.example.id]
+  host                = "oracle.example.com"
+  service_name        = "FINPDB1"
+  username            = "hush_admin"
+  password_wo         = var.oracle_password
+  password_wo_version = "1"
+}
+
+# Create an Oracle Autonomous Database credential that connects with mutual TLS
+resource "hush_oracle_access_credential" "autonomous" {
+  name                = "finance-adb"
+  deployment_ids      = [hush_deployment.example.id]
+  host                = "adb.eu-frankfurt-1.oraclecloud.com"
+  port                = 1522
+  service_name        = "fin_high.adb.oraclecloud.com"
+  tls                 = true
+  wallet_wo           = filebase64("${path.module}/Wallet_FIN.zip")
+  wallet_wo_version   = "1"
+  username            = "ADMIN"
+  password_wo         = var.adb_admin_password
+  password_wo_version = "1"
+}
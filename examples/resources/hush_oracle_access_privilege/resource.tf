@@ -0,0 +1,21 @@
+# Create an Oracle Database access privilege
+resource "hush_oracle_access_privilege" "example" {
+  name              = "finance-read-write"
+  description       = "Read/write access to the finance ledger"
+  system_privileges = ["CREATE SESSION"]
+  roles             = ["FINANCE_READER"]
+
+  object_grants {
+    privileges   = ["SELECT", "INSERT", "UPDATE"]
+    object_type  = "TABLE"
+    schema_name  = "FINANCE"
+    object_names = ["LEDGER", "INVOICES"]
+  }
+
+  object_grants {
+    privileges    = ["EXECUTE"]
+    object_type   = "PACKAGE"
+    schema_name   = "FINANCE"
+    all_in_schema = true
+  }
+}
@@ -16,6 +16,32 @@ resource "hush_plaintext_access_credential" "example_write_only" {
   secret_wo_version = "v1" # Change this value when rotating the secret
 }
 
+# Create a plaintext access credential that is due for rotation every 90 days.
+# Supplying a new secret_wo_version installs the new secret and restarts the
+# interval; changing rotate_now asks Hush to rotate it on the next apply.
+resource "hush_plaintext_access_credential" "example_rotated" {
+  name              = "example-api-key-rotated"
+  description       = "API key rotated on a schedule"
+  deployment_ids    = ["dep-example123456789"]
+  secret_wo         = "sk-1234567890abcdef"
+  secret_wo_version = "v1"
+
+  rotation {
+    interval_days = 90
+  }
+}
+
+# Store a binary secret, such as a PKCS#12 keystore, as base64. Volume
+# delivery writes the decoded bytes to the mounted file.
+resource "hush_plaintext_access_credential" "example_keystore" {
+  name                     = "example-keystore"
+  description              = "TLS keystore for the payments service"
+  deployment_ids           = ["dep-example123456789"]
+  secret_base64_wo         = filebase64("${path.module}/keystore.p12")
+  secret_base64_wo_version = "v1"
+  content_type             = "application/x-pkcs12"
+}
+
 # Output the credential ID for reference
 output "credential_id" {
   value = hush_plaintext_access_credential.example.id
@@ -10,3 +10,39 @@ resource "hush_postgres_access_credential" "example" {
   username       = "app_user"
   password_wo    = var.postgres_password
 }
+
+# Authenticate to Amazon RDS with IAM instead of a password. With no AWS keys,
+# Hush signs authentication tokens with its workload identity.
+resource "hush_postgres_access_credential" "rds_iam" {
+  name           = "prod-postgres-rds"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "rds_iam"
+  db_name        = "mydb"
+  host           = "mydb.cluster-abc123.us-east-1.rds.amazonaws.com"
+  ssl_mode       = "verify-full"
+  username       = "hush_admin"
+  region         = "us-east-1"
+}
+
+# Authenticate to Cloud SQL as an IAM service account user
+resource "hush_postgres_access_credential" "cloudsql_iam" {
+  name                     = "prod-postgres-cloudsql"
+  deployment_ids           = [hush_deployment.example.id]
+  engine                   = "cloudsql_iam"
+  db_name                  = "mydb"
+  host                     = "10.20.0.5"
+  username                 = "hush-admin@my-project.iam"
+  instance_connection_name = "my-project:us-central1:mydb"
+}
+
+# Authenticate to Azure Database for PostgreSQL with Microsoft Entra ID
+resource "hush_postgres_access_credential" "azure_ad" {
+  name           = "prod-postgres-azure"
+  deployment_ids = [hush_deployment.example.id]
+  engine         = "azure_ad"
+  db_name        = "mydb"
+  host           = "mydb.postgres.database.azure.com"
+  ssl_mode       = "require"
+  username       = "hush-admin"
+  tenant_id      = "11111111-2222-3333-4444-555555555555"
+}
@@ -1,6 +1,7 @@
 # A secret store has exactly one backend configuration block, chosen from the
-# supported kinds below. The backend configuration is immutable: changing any field
-# in the block (or switching to a different kind) replaces the store.
+# supported kinds below. Switching to a different kind replaces the store. Fields
+# such as kms_key_id are updated in place; changing where secrets are kept, such as
+# prefix or region, moves every credential to a new store and deletes the old one.
 
 # AWS Secrets Manager
 resource "hush_secret_store" "aws_sm" {
@@ -48,3 +49,55 @@ resource "hush_secret_store" "k8s" {
     namespace = "hush-secrets" # optional; defaults to the access-manager namespace
   }
 }
+
+# Azure Key Vault
+resource "hush_secret_store" "azure_kv" {
+  name           = "prod-azure-kv"
+  deployment_ids = ["dep-xxxxxxxxxxxxxxxx"]
+
+  azure_kv {
+    prefix                     = "hush"
+    vault_url                  = "https://prod-secrets.vault.azure.net/"
+    tenant_id                  = "00000000-0000-0000-0000-000000000000"
+    managed_identity_client_id = "00000000-0000-0000-0000-000000000000" # optional; defaults to the access-manager's Azure credentials
+  }
+}
+
+# HashiCorp Vault, logging in with the Kubernetes auth method
+resource "hush_secret_store" "vault" {
+  name           = "prod-vault"
+  deployment_ids = ["dep-xxxxxxxxxxxxxxxx"]
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    namespace   = "admin/platform" # optional; Vault Enterprise only
+    auth_method = "kubernetes"
+    role        = "hush-access-manager"
+  }
+}
+
+# HashiCorp Vault, logging in with AppRole
+variable "vault_role_id" {
+  type = string
+}
+
+variable "vault_secret_id" {
+  type      = string
+  sensitive = true
+}
+
+resource "hush_secret_store" "vault_approle" {
+  name           = "ci-vault"
+  deployment_ids = ["dep-xxxxxxxxxxxxxxxx"]
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    auth_method = "approle"
+    role_id     = var.vault_role_id
+    secret_id   = var.vault_secret_id
+  }
+}
@@ -0,0 +1,9 @@
+# Move every credential from AWS Secrets Manager to SSM Parameter Store
+resource "hush_secret_store_migration" "to_ssm" {
+  source_secret_store_id = hush_secret_store.secrets_manager.id
+  target_secret_store_id = hush_secret_store.parameter_store.id
+}
+
+output "migrated_credentials" {
+  value = "${hush_secret_store_migration.to_ssm.migrated_credentials} of ${hush_secret_store_migration.to_ssm.total_credentials}"
+}
@@ -0,0 +1,133 @@
+// Package accessschedule parses the recurring schedules carried by access
+// policies and evaluates whether a policy is in force at a given time.
+//
+// A schedule is a standard five-field cron expression (minute, hour, day of
+// month, month, day of week) read in a time zone. The policy is active during
+// every minute the expression matches, so "* 9-17 * * 1-5" means working hours
+// on weekdays. Fields take *, a value, a range a-b, a step */n or a-b/n, and
+// comma-separated lists of these; names such as MON are not accepted. As in
+// cron, when both day of month and day of week are restricted a day matching
+// either one matches.
+package accessschedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = [5]field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	sets [5]uint64
+	// domStar and dowStar record an unrestricted day field, which decides
+	// whether the two day fields combine with AND or OR.
+	domStar, dowStar bool
+}
+
+// Parse parses a five-field cron expression.
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(parts))
+	}
+
+	s := &Schedule{}
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, err
+		}
+		s.sets[i] = set
+	}
+	// Day of week 7 is Sunday, like 0.
+	if s.sets[4]&(1<<7) != 0 {
+		s.sets[4] |= 1
+	}
+	s.domStar = parts[2] == "*"
+	s.dowStar = parts[4] == "*"
+	return s, nil
+}
+
+func parseField(part string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		lo, hi := f.min, f.max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(from, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseValue(to, f); err != nil {
+					return 0, err
+				}
+				if hi < lo {
+					return 0, fmt.Errorf("%s range %q is reversed", f.name, rangePart)
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+		}
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%s step %q must be a positive integer", f.name, stepPart)
+			}
+			step = n
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s value %q is not a number", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d is outside %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Matches reports whether the schedule is active during the minute containing t,
+// read in t's location.
+func (s *Schedule) Matches(t time.Time) bool {
+	if !s.has(0, t.Minute()) || !s.has(1, t.Hour()) || !s.has(3, int(t.Month())) {
+		return false
+	}
+	dom := s.has(2, t.Day())
+	dow := s.has(4, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (s *Schedule) has(i, v int) bool {
+	return s.sets[i]&(1<<v) != 0
+}
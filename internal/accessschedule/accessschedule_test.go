@@ -0,0 +1,70 @@
+package accessschedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{"every minute", "* * * * *", false},
+		{"working hours", "* 9-17 * * 1-5", false},
+		{"steps and lists", "*/15 0,12 1-15/2 1,6 *", false},
+		{"sunday as 7", "* * * * 7", false},
+		{"too few fields", "* * * *", true},
+		{"too many fields", "0 * * * * *", true},
+		{"minute out of range", "60 * * * *", true},
+		{"day of month zero", "* * 0 * *", true},
+		{"reversed range", "* 17-9 * * *", true},
+		{"zero step", "*/0 * * * *", true},
+		{"named weekday", "* * * * MON", true},
+		{"empty list item", "1,,2 * * * *", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.expr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	// 2026-03-02 is a Monday.
+	monday0930 := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	sunday0930 := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	monday1800 := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		expr string
+		t    time.Time
+		want bool
+	}{
+		{"inside working hours", "* 9-17 * * 1-5", monday0930, true},
+		{"after working hours", "* 9-17 * * 1-5", monday1800, false},
+		{"weekend", "* 9-17 * * 1-5", sunday0930, false},
+		{"sunday as 7", "* * * * 7", sunday0930, true},
+		{"minute step", "*/15 * * * *", monday0930, true},
+		{"minute step miss", "*/20 * * * *", monday0930, false},
+		{"day of month or day of week", "* * 15 * 1", monday0930, true},
+		{"day of month and unrestricted day of week", "* * 15 * *", monday0930, false},
+		{"month", "* * * 4 *", monday0930, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.expr)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if got := s.Matches(tc.t); got != tc.want {
+				t.Errorf("expected %v for %s at %s, got %v", tc.want, tc.expr, tc.t, got)
+			}
+		})
+	}
+}
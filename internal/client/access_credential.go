@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 const accessCredentialsEndpoint = "/v1/access_credentials"
@@ -16,6 +17,16 @@ const (
 	AccessCredentialTypeKV        AccessCredentialType = "kv"
 )
 
+// SecretEncoding tells the API how a plaintext credential's secret is
+// encoded in requests. A base64 secret is stored and delivered to volumes as
+// its decoded bytes.
+type SecretEncoding string
+
+const (
+	SecretEncodingText   SecretEncoding = "text"
+	SecretEncodingBase64 SecretEncoding = "base64"
+)
+
 type AccessCredential struct {
 	ID            string               `json:"id,omitempty"`
 	Name          string               `json:"name"`
@@ -23,8 +34,47 @@ type AccessCredential struct {
 	Type          AccessCredentialType `json:"type"`
 	DeploymentIDs []string             `json:"deployment_ids"`
 	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Keys          []string             `json:"keys,omitempty"`
-	CreatedBy     string               `json:"created_by,omitempty"`
+	// SecretStoreSync reports the credential's state in each secret store
+	// holding its values.
+	SecretStoreSync []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	Keys            []string                  `json:"keys,omitempty"`
+	CreatedBy       string                    `json:"created_by,omitempty"`
+	Rotation        *AccessCredentialRotation `json:"rotation,omitempty"`
+	// SecretFingerprint is a salted hash of the secret that changes whenever
+	// the secret does, however it is changed.
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
+	// SecretEncoding and ContentType are only reported for plaintext
+	// credentials.
+	SecretEncoding SecretEncoding `json:"secret_encoding,omitempty"`
+	ContentType    string         `json:"content_type,omitempty"`
+}
+
+// AccessCredentialRotation is a credential's rotation schedule. The API
+// rotates the credential every IntervalDays and reports when it last did and
+// when it will next; installing a new secret version counts as a rotation
+// and restarts the interval.
+type AccessCredentialRotation struct {
+	IntervalDays   int    `json:"interval_days"`
+	LastRotatedAt  string `json:"last_rotated_at,omitempty"`
+	NextRotationAt string `json:"next_rotation_at,omitempty"`
+}
+
+// rotationUpdate marshals to null when Rotation is nil (removing the schedule)
+// and to the schedule otherwise. A nil wrapper on the input is omitted (no
+// change).
+type rotationUpdate struct{ Rotation *AccessCredentialRotation }
+
+func (u rotationUpdate) MarshalJSON() ([]byte, error) {
+	if u.Rotation == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.Rotation)
+}
+
+// NewRotationUpdate wraps a rotation schedule (possibly nil for removal) for an
+// update request, forcing the rotation field to be sent.
+func NewRotationUpdate(rotation *AccessCredentialRotation) *rotationUpdate {
+	return &rotationUpdate{Rotation: rotation}
 }
 
 type PlaintextAccessCredential struct {
@@ -43,19 +93,23 @@ type KVAccessCredential struct {
 }
 
 type CreatePlaintextAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	Secret        string   `json:"secret"`
+	Name           string                    `json:"name"`
+	Description    string                    `json:"description,omitempty"`
+	DeploymentIDs  []string                  `json:"deployment_ids"`
+	SecretStoreID  string                    `json:"secret_store_id,omitempty"`
+	Secret         string                    `json:"secret"`
+	SecretEncoding SecretEncoding            `json:"secret_encoding,omitempty"`
+	ContentType    string                    `json:"content_type,omitempty"`
+	Rotation       *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type CreateKVAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	Items         []KVItem `json:"items"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	Items         []KVItem                  `json:"items"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdatePlaintextAccessCredentialInput struct {
@@ -63,13 +117,21 @@ type UpdatePlaintextAccessCredentialInput struct {
 	Description   *string              `json:"description,omitempty"`
 	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
 	Secret        *string              `json:"secret,omitempty"`
+	// SecretEncoding is sent with every new Secret.
+	SecretEncoding SecretEncoding  `json:"secret_encoding,omitempty"`
+	ContentType    *nullableString `json:"content_type,omitempty"`
+	Rotation       *rotationUpdate `json:"rotation,omitempty"`
 }
 
 type UpdateKVAccessCredentialInput struct {
 	Name          *string              `json:"name,omitempty"`
 	Description   *string              `json:"description,omitempty"`
 	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
-	Items         []KVItem             `json:"items,omitempty"`
+	// UpsertItems and DeleteKeys change individual items, leaving the
+	// credential's other items as they are.
+	UpsertItems []KVItem        `json:"upsert_items,omitempty"`
+	DeleteKeys  []string        `json:"delete_keys,omitempty"`
+	Rotation    *rotationUpdate `json:"rotation,omitempty"`
 }
 
 // secretStoreIDUpdate marshals to null when ID is empty (detaching the credential
@@ -178,6 +240,46 @@ func UpdateKVAccessCredential(ctx context.Context, c *Client, id string, input *
 	return &result, nil
 }
 
+// RotateAccessCredential rotates the credential now, outside its schedule,
+// and returns its rotation state afterwards.
+func RotateAccessCredential(ctx context.Context, c *Client, id string) (*AccessCredentialRotation, error) {
+	path := fmt.Sprintf("%s/%s/rotate", accessCredentialsEndpoint, id)
+	var result AccessCredentialRotation
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AccessCredentialCheck is the result of a connectivity and permission test
+// run against a credential's target through a deployment's access bridge.
+type AccessCredentialCheck struct {
+	DeploymentID  string `json:"deployment_id"`
+	Reachable     bool   `json:"reachable"`
+	AuthOK        bool   `json:"auth_ok"`
+	CanCreateUser bool   `json:"can_create_user"`
+	TLSVerified   bool   `json:"tls_verified"`
+	LatencyMS     int    `json:"latency_ms"`
+	Detail        string `json:"detail,omitempty"`
+	CheckedAt     string `json:"checked_at,omitempty"`
+}
+
+type CheckAccessCredentialInput struct {
+	DeploymentID string `json:"deployment_id,omitempty"`
+}
+
+// CheckAccessCredential tests the credential's connection details from the
+// deployment's access bridge and returns the result. A failing test is a
+// result, not an error; an error means the test could not be run.
+func CheckAccessCredential(ctx context.Context, c *Client, id string, input *CheckAccessCredentialInput) (*AccessCredentialCheck, error) {
+	path := fmt.Sprintf("%s/%s/check", accessCredentialsEndpoint, id)
+	var result AccessCredentialCheck
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func DeleteAccessCredential(ctx context.Context, c *Client, id string) error {
 	path := fmt.Sprintf("%s/%s", accessCredentialsEndpoint, id)
 	err := c.doRequest(ctx, http.MethodDelete, path, nil, nil)
@@ -186,3 +288,23 @@ func DeleteAccessCredential(ctx context.Context, c *Client, id string) error {
 	}
 	return nil
 }
+
+// AccessCredentialListResponse matches the backend CursorPage shape.
+type AccessCredentialListResponse struct {
+	Items    []AccessCredential `json:"items"`
+	NextPage *string            `json:"next_page"`
+}
+
+// GetAccessCredentialsByDeployment returns every access credential, of any
+// type, available to the deployment, using the backend's server-side
+// deployment filter and paging through every result.
+func GetAccessCredentialsByDeployment(ctx context.Context, c *Client, deploymentID string) ([]AccessCredential, error) {
+	base := fmt.Sprintf("%s?deployment_id=%s", accessCredentialsEndpoint, url.QueryEscape(deploymentID))
+	return collectPages(func(cursor string) ([]AccessCredential, *string, error) {
+		var page AccessCredentialListResponse
+		if err := c.doRequest(ctx, http.MethodGet, withCursor(base, cursor), nil, &page); err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.NextPage, nil
+	})
+}
@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 const accessPoliciesEndpoint = "/v1/access_policies"
@@ -55,16 +57,42 @@ type EnvDeliveryItem struct {
 	Type DeliveryMappingType `json:"type,omitempty"`
 }
 
+// VolumeRenderFormat is the file format a whole credential is rendered in.
+type VolumeRenderFormat string
+
+const (
+	VolumeRenderFormatEnv        VolumeRenderFormat = "env"
+	VolumeRenderFormatJSON       VolumeRenderFormat = "json"
+	VolumeRenderFormatYAML       VolumeRenderFormat = "yaml"
+	VolumeRenderFormatProperties VolumeRenderFormat = "properties"
+)
+
+// VolumeDeliveryItem is one file in a volume. Mode is an octal string such as
+// "0400"; Mode, UID and GID are omitted to keep the agent's defaults. UID and
+// GID are pointers so that root (0) can be sent.
 type VolumeDeliveryItem struct {
 	Path string              `json:"path"`
 	Key  string              `json:"key,omitempty"`
 	Type DeliveryMappingType `json:"type,omitempty"`
+	Mode string              `json:"mode,omitempty"`
+	UID  *int                `json:"uid,omitempty"`
+	GID  *int                `json:"gid,omitempty"`
+}
+
+// VolumeRenderAs renders every key of the credential into a single file.
+type VolumeRenderAs struct {
+	Path   string             `json:"path"`
+	Format VolumeRenderFormat `json:"format"`
+	Mode   string             `json:"mode,omitempty"`
+	UID    *int               `json:"uid,omitempty"`
+	GID    *int               `json:"gid,omitempty"`
 }
 
 type VolumeDeliveryConfig struct {
 	Type       DeliveryType         `json:"type"`
 	MountPoint string               `json:"mount_point"`
-	Items      []VolumeDeliveryItem `json:"items"`
+	Items      []VolumeDeliveryItem `json:"items,omitempty"`
+	RenderAs   *VolumeRenderAs      `json:"render_as,omitempty"`
 }
 
 type EnvDeliveryConfig struct {
@@ -107,6 +135,22 @@ type SdkDeliveryConfig struct {
 	Items      []SdkDeliveryItem `json:"items"`
 }
 
+// AccessPolicyDeploymentStatus is the sync status of a policy in one of its
+// deployments.
+type AccessPolicyDeploymentStatus struct {
+	DeploymentID string `json:"deployment_id"`
+	Status       string `json:"status"`
+	StatusDetail string `json:"status_detail,omitempty"`
+	LastSyncedAt string `json:"last_synced_at,omitempty"`
+}
+
+// AccessPolicySchedule limits a policy to the minutes a cron expression
+// matches, read in Timezone (an IANA name such as Europe/London).
+type AccessPolicySchedule struct {
+	Cron     string `json:"cron"`
+	Timezone string `json:"timezone"`
+}
+
 type AccessPolicy struct {
 	ID                  string                 `json:"id,omitempty"`
 	Name                string                 `json:"name"`
@@ -116,9 +160,25 @@ type AccessPolicy struct {
 	AccessPrivilegeIDs  []string               `json:"access_privilege_ids,omitempty"`
 	AttestationCriteria []AttestationCriterion `json:"attestation_criteria"`
 	DeploymentIDs       []string               `json:"deployment_ids"`
-	DeliveryConfig      any                    `json:"delivery_config"`
-	Status              string                 `json:"status,omitempty"`
-	StatusDetail        string                 `json:"status_detail,omitempty"`
+	// The API holds one field or the other, never both. Both are read: a policy
+	// this provider has not written since the list was introduced still answers
+	// through the singular field.
+	DeliveryConfig  any    `json:"delivery_config,omitempty"`
+	DeliveryConfigs []any  `json:"delivery_configs,omitempty"`
+	Status          string `json:"status,omitempty"`
+	StatusDetail    string `json:"status_detail,omitempty"`
+	// Status and StatusDetail summarise every deployment, so one failing
+	// deployment marks the whole policy; this reports each separately.
+	DeploymentStatuses []AccessPolicyDeploymentStatus `json:"deployment_statuses,omitempty"`
+	// NotBefore and ExpiresAt are RFC3339 timestamps bounding when the policy
+	// is in force; either may be empty for an open end.
+	NotBefore string                `json:"not_before,omitempty"`
+	ExpiresAt string                `json:"expires_at,omitempty"`
+	Schedule  *AccessPolicySchedule `json:"schedule,omitempty"`
+	// EffectiveEnabled is whether the policy is in force now, taking enabled,
+	// the time window and the schedule together. Nil when the API does not
+	// report it.
+	EffectiveEnabled *bool `json:"effective_enabled,omitempty"`
 }
 
 type CreateAccessPolicyInput struct {
@@ -129,7 +189,12 @@ type CreateAccessPolicyInput struct {
 	AccessPrivilegeIDs  []string               `json:"access_privilege_ids,omitempty"`
 	AttestationCriteria []AttestationCriterion `json:"attestation_criteria"`
 	DeploymentIDs       []string               `json:"deployment_ids"`
-	DeliveryConfig      any                    `json:"delivery_config"`
+	// Only the list is ever written. The singular field is left out, which a
+	// create reads as absent, so the two are never sent together.
+	DeliveryConfigs []any                 `json:"delivery_configs"`
+	NotBefore       string                `json:"not_before,omitempty"`
+	ExpiresAt       string                `json:"expires_at,omitempty"`
+	Schedule        *AccessPolicySchedule `json:"schedule,omitempty"`
 }
 
 type UpdateAccessPolicyInput struct {
@@ -140,15 +205,65 @@ type UpdateAccessPolicyInput struct {
 	AccessPrivilegeIDs  *[]string               `json:"access_privilege_ids,omitempty"`
 	AttestationCriteria *[]AttestationCriterion `json:"attestation_criteria,omitempty"`
 	DeploymentIDs       *[]string               `json:"deployment_ids,omitempty"`
-	DeliveryConfig      any                     `json:"delivery_config,omitempty"`
+	DeliveryConfig      *deliveryConfigUpdate   `json:"delivery_config,omitempty"`
+	DeliveryConfigs     []any                   `json:"delivery_configs,omitempty"`
+	NotBefore           *nullableString         `json:"not_before,omitempty"`
+	ExpiresAt           *nullableString         `json:"expires_at,omitempty"`
+	Schedule            *scheduleUpdate         `json:"schedule,omitempty"`
+	// ExpectedDeploymentIDs makes the update conditional: the API refuses it
+	// with 409 or 412 unless the policy's deployment_ids still equal this list.
+	ExpectedDeploymentIDs *[]string `json:"expected_deployment_ids,omitempty"`
 }
 
-func CreateAccessPolicy(ctx context.Context, c *Client, input *CreateAccessPolicyInput) (*AccessPolicy, error) {
+// scheduleUpdate marshals to null when Schedule is nil (removal) and to the
+// schedule otherwise. A nil wrapper on the input is omitted (no change).
+type scheduleUpdate struct{ Schedule *AccessPolicySchedule }
+
+func (u scheduleUpdate) MarshalJSON() ([]byte, error) {
+	if u.Schedule == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.Schedule)
+}
+
+// NewScheduleUpdate wraps a schedule (possibly nil for removal) for an update
+// request, forcing the schedule field to be sent.
+func NewScheduleUpdate(schedule *AccessPolicySchedule) *scheduleUpdate {
+	return &scheduleUpdate{Schedule: schedule}
+}
+
+// deliveryConfigUpdate marshals to null when Config is nil (removal) and to the
+// config object otherwise. A nil wrapper on the input is omitted (no change).
+type deliveryConfigUpdate struct{ Config any }
+
+func (u deliveryConfigUpdate) MarshalJSON() ([]byte, error) {
+	if u.Config == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.Config)
+}
+
+// NewDeliveryConfigUpdate wraps a delivery config (possibly nil for removal)
+// for an update request, forcing the delivery_config field to be sent.
+func NewDeliveryConfigUpdate(config any) *deliveryConfigUpdate {
+	return &deliveryConfigUpdate{Config: config}
+}
+
+// AccessPolicyListResponse matches the backend CursorPage shape.
+type AccessPolicyListResponse struct {
+	Items    []AccessPolicy `json:"items"`
+	NextPage *string        `json:"next_page"`
+}
+
+// CreateAccessPolicy creates a policy and waits for it to sync. When
+// requiredDeployments is empty the wait is on the policy's overall status;
+// otherwise only on those deployments, see waitForAccessPolicy.
+func CreateAccessPolicy(ctx context.Context, c *Client, input *CreateAccessPolicyInput, requiredDeployments []string) (*AccessPolicy, error) {
 	var result AccessPolicy
 	if err := c.doRequest(ctx, http.MethodPost, accessPoliciesEndpoint, input, &result); err != nil {
 		return nil, err
 	}
-	if err := waitForResourceStatus(ctx, c, result.ID, GetAccessPolicy); err != nil {
+	if err := waitForAccessPolicy(ctx, c, result.ID, requiredDeployments); err != nil {
 		return &result, err
 	}
 	return &result, nil
@@ -163,13 +278,15 @@ func GetAccessPolicy(ctx context.Context, c *Client, id string) (*AccessPolicy,
 	return &policy, nil
 }
 
-func UpdateAccessPolicy(ctx context.Context, c *Client, id string, input *UpdateAccessPolicyInput) (*AccessPolicy, error) {
+// UpdateAccessPolicy updates a policy and waits for it to sync, on the same
+// terms as CreateAccessPolicy.
+func UpdateAccessPolicy(ctx context.Context, c *Client, id string, input *UpdateAccessPolicyInput, requiredDeployments []string) (*AccessPolicy, error) {
 	path := fmt.Sprintf("%s/%s", accessPoliciesEndpoint, id)
 	var result AccessPolicy
 	if err := c.doRequest(ctx, http.MethodPatch, path, input, &result); err != nil {
 		return nil, err
 	}
-	if err := waitForResourceStatus(ctx, c, id, GetAccessPolicy); err != nil {
+	if err := waitForAccessPolicy(ctx, c, id, requiredDeployments); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -179,6 +296,59 @@ func (p AccessPolicy) statusFields() (string, string) {
 	return p.Status, p.StatusDetail
 }
 
+// waitForAccessPolicy polls the policy until it reaches a terminal status.
+// With no required deployments that is the overall status. Otherwise it is
+// the status of the required deployments alone, so a deployment outside the
+// set neither fails nor holds up the wait.
+func waitForAccessPolicy(ctx context.Context, c *Client, id string, requiredDeployments []string) error {
+	if len(requiredDeployments) == 0 {
+		return waitForResourceStatus(ctx, c, id, GetAccessPolicy)
+	}
+	return waitForStatus(ctx, func() (string, string, error) {
+		policy, err := GetAccessPolicy(ctx, c, id)
+		if err != nil {
+			return "", "", err
+		}
+		status, detail := requiredDeploymentsStatus(policy, requiredDeployments)
+		return status, detail, nil
+	})
+}
+
+// requiredDeploymentsStatus folds the status of the required deployments into
+// one, in the terms waitForStatus understands: the first failing deployment's
+// status, "ok" once every one is ok or disabled, "syncing" otherwise. A policy
+// that reports no per-deployment status falls back to its overall status.
+func requiredDeploymentsStatus(policy *AccessPolicy, requiredDeployments []string) (string, string) {
+	if len(policy.DeploymentStatuses) == 0 {
+		return policy.statusFields()
+	}
+
+	byDeployment := make(map[string]AccessPolicyDeploymentStatus, len(policy.DeploymentStatuses))
+	for _, s := range policy.DeploymentStatuses {
+		byDeployment[s.DeploymentID] = s
+	}
+
+	settled := true
+	for _, id := range requiredDeployments {
+		s, ok := byDeployment[id]
+		if !ok {
+			settled = false
+			continue
+		}
+		switch s.Status {
+		case "ok", "disabled":
+		case "warning", "error":
+			return s.Status, fmt.Sprintf("deployment %s: %s", id, s.StatusDetail)
+		default:
+			settled = false
+		}
+	}
+	if !settled {
+		return "syncing", ""
+	}
+	return "ok", ""
+}
+
 func DeleteAccessPolicy(ctx context.Context, c *Client, id string) error {
 	path := fmt.Sprintf("%s/%s", accessPoliciesEndpoint, id)
 	if err := c.doRequest(ctx, http.MethodDelete, path, nil, nil); err != nil {
@@ -186,3 +356,17 @@ func DeleteAccessPolicy(ctx context.Context, c *Client, id string) error {
 	}
 	return nil
 }
+
+// GetAccessPoliciesByDeployment returns every access policy that applies to the
+// deployment, using the backend's server-side deployment filter and paging
+// through every result.
+func GetAccessPoliciesByDeployment(ctx context.Context, c *Client, deploymentID string) ([]AccessPolicy, error) {
+	base := fmt.Sprintf("%s?deployment_id=%s", accessPoliciesEndpoint, url.QueryEscape(deploymentID))
+	return collectPages(func(cursor string) ([]AccessPolicy, *string, error) {
+		var page AccessPolicyListResponse
+		if err := c.doRequest(ctx, http.MethodGet, withCursor(base, cursor), nil, &page); err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.NextPage, nil
+	})
+}
@@ -0,0 +1,67 @@
+package client
+
+import "testing"
+
+// TestRequiredDeploymentsStatus verifies that only the required deployments
+// decide the wait: one failing outside the set is ignored, one failing inside
+// it fails the wait, and one not yet reported keeps it polling.
+func TestRequiredDeploymentsStatus(t *testing.T) {
+	statuses := []AccessPolicyDeploymentStatus{
+		{DeploymentID: "dep-prod", Status: "ok"},
+		{DeploymentID: "dep-dev", Status: "error", StatusDetail: "agent unreachable"},
+		{DeploymentID: "dep-staging", Status: "syncing"},
+	}
+
+	tests := []struct {
+		name       string
+		policy     AccessPolicy
+		required   []string
+		wantStatus string
+		wantDetail string
+	}{
+		{
+			name:       "failure outside the set is ignored",
+			policy:     AccessPolicy{Status: "error", DeploymentStatuses: statuses},
+			required:   []string{"dep-prod"},
+			wantStatus: "ok",
+		},
+		{
+			name:       "failure inside the set fails",
+			policy:     AccessPolicy{Status: "error", DeploymentStatuses: statuses},
+			required:   []string{"dep-prod", "dep-dev"},
+			wantStatus: "error",
+			wantDetail: "deployment dep-dev: agent unreachable",
+		},
+		{
+			name:       "syncing inside the set keeps polling",
+			policy:     AccessPolicy{Status: "error", DeploymentStatuses: statuses},
+			required:   []string{"dep-staging"},
+			wantStatus: "syncing",
+		},
+		{
+			name:       "unreported deployment keeps polling",
+			policy:     AccessPolicy{Status: "ok", DeploymentStatuses: statuses},
+			required:   []string{"dep-other"},
+			wantStatus: "syncing",
+		},
+		{
+			name:       "no per-deployment status falls back to overall",
+			policy:     AccessPolicy{Status: "warning", StatusDetail: "partial"},
+			required:   []string{"dep-prod"},
+			wantStatus: "warning",
+			wantDetail: "partial",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status, detail := requiredDeploymentsStatus(&tc.policy, tc.required)
+			if status != tc.wantStatus {
+				t.Errorf("expected status %q, got %q", tc.wantStatus, status)
+			}
+			if detail != tc.wantDetail {
+				t.Errorf("expected detail %q, got %q", tc.wantDetail, detail)
+			}
+		})
+	}
+}
@@ -175,6 +175,132 @@ func UpdateMySQLAccessPrivilege(ctx context.Context, c *Client, id string, input
 	return &resp, nil
 }
 
+// MSSQL
+
+// MSSQLGrant grants privileges on objects in one schema of the credential's
+// database. For object_type SCHEMA the schema itself is the securable, and for
+// DATABASE the database is.
+type MSSQLGrant struct {
+	Privileges  []string `json:"privileges"`
+	ObjectType  string   `json:"object_type"`
+	SchemaName  string   `json:"schema_name,omitempty"`
+	ObjectNames []string `json:"object_names,omitempty"`
+	ColumnNames []string `json:"column_names,omitempty"`
+	AllInSchema bool     `json:"all_in_schema,omitempty"`
+}
+
+type MSSQLAccessPrivilege struct {
+	ID          string       `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Type        string       `json:"type,omitempty"`
+	Grants      []MSSQLGrant `json:"grants"`
+}
+
+type CreateMSSQLAccessPrivilegeInput struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Grants      []MSSQLGrant `json:"grants"`
+}
+
+type UpdateMSSQLAccessPrivilegeInput struct {
+	Name        *string       `json:"name,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	Grants      *[]MSSQLGrant `json:"grants,omitempty"`
+}
+
+func CreateMSSQLAccessPrivilege(ctx context.Context, c *Client, input *CreateMSSQLAccessPrivilegeInput) (*MSSQLAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/mssql"
+	var resp MSSQLAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetMSSQLAccessPrivilege(ctx context.Context, c *Client, id string) (*MSSQLAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/mssql/%s", accessPrivilegesEndpoint, id)
+	var resp MSSQLAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateMSSQLAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateMSSQLAccessPrivilegeInput) (*MSSQLAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/mssql/%s", accessPrivilegesEndpoint, id)
+	var resp MSSQLAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Oracle
+
+// OracleObjectGrant grants privileges on objects owned by one schema. With
+// AllInSchema it covers every object of ObjectType the schema owns.
+type OracleObjectGrant struct {
+	Privileges  []string `json:"privileges"`
+	ObjectType  string   `json:"object_type"`
+	SchemaName  string   `json:"schema_name"`
+	ObjectNames []string `json:"object_names,omitempty"`
+	AllInSchema bool     `json:"all_in_schema,omitempty"`
+}
+
+type OracleAccessPrivilege struct {
+	ID               string              `json:"id,omitempty"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description,omitempty"`
+	Type             string              `json:"type,omitempty"`
+	SystemPrivileges []string            `json:"system_privileges"`
+	ObjectGrants     []OracleObjectGrant `json:"object_grants"`
+	Roles            []string            `json:"roles"`
+}
+
+type CreateOracleAccessPrivilegeInput struct {
+	Name             string              `json:"name"`
+	Description      string              `json:"description,omitempty"`
+	SystemPrivileges []string            `json:"system_privileges"`
+	ObjectGrants     []OracleObjectGrant `json:"object_grants"`
+	Roles            []string            `json:"roles"`
+}
+
+type UpdateOracleAccessPrivilegeInput struct {
+	Name             *string              `json:"name,omitempty"`
+	Description      *string              `json:"description,omitempty"`
+	SystemPrivileges *[]string            `json:"system_privileges,omitempty"`
+	ObjectGrants     *[]OracleObjectGrant `json:"object_grants,omitempty"`
+	Roles            *[]string            `json:"roles,omitempty"`
+}
+
+func CreateOracleAccessPrivilege(ctx context.Context, c *Client, input *CreateOracleAccessPrivilegeInput) (*OracleAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/oracle"
+	var resp OracleAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetOracleAccessPrivilege(ctx context.Context, c *Client, id string) (*OracleAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/oracle/%s", accessPrivilegesEndpoint, id)
+	var resp OracleAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateOracleAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateOracleAccessPrivilegeInput) (*OracleAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/oracle/%s", accessPrivilegesEndpoint, id)
+	var resp OracleAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // OpenAI
 
 type OpenAIPermission struct {
@@ -1132,23 +1258,26 @@ type KafkaAclEntry struct {
 }
 
 type KafkaAccessPrivilege struct {
-	ID          string          `json:"id,omitempty"`
-	Name        string          `json:"name"`
-	Description string          `json:"description,omitempty"`
-	Type        string          `json:"type,omitempty"`
-	Acls        []KafkaAclEntry `json:"acls"`
+	ID                     string          `json:"id,omitempty"`
+	Name                   string          `json:"name"`
+	Description            string          `json:"description,omitempty"`
+	Type                   string          `json:"type,omitempty"`
+	Acls                   []KafkaAclEntry `json:"acls"`
+	ConfluentAuthorization string          `json:"confluent_authorization,omitempty"`
 }
 
 type CreateKafkaAccessPrivilegeInput struct {
-	Name        string          `json:"name"`
-	Description string          `json:"description,omitempty"`
-	Acls        []KafkaAclEntry `json:"acls"`
+	Name                   string          `json:"name"`
+	Description            string          `json:"description,omitempty"`
+	Acls                   []KafkaAclEntry `json:"acls"`
+	ConfluentAuthorization string          `json:"confluent_authorization,omitempty"`
 }
 
 type UpdateKafkaAccessPrivilegeInput struct {
-	Name        *string          `json:"name,omitempty"`
-	Description *string          `json:"description,omitempty"`
-	Acls        *[]KafkaAclEntry `json:"acls,omitempty"`
+	Name                   *string          `json:"name,omitempty"`
+	Description            *string          `json:"description,omitempty"`
+	Acls                   *[]KafkaAclEntry `json:"acls,omitempty"`
+	ConfluentAuthorization *string          `json:"confluent_authorization,omitempty"`
 }
 
 func CreateKafkaAccessPrivilege(ctx context.Context, c *Client, input *CreateKafkaAccessPrivilegeInput) (*KafkaAccessPrivilege, error) {
@@ -1178,7 +1307,287 @@ func UpdateKafkaAccessPrivilege(ctx context.Context, c *Client, id string, input
 	return &resp, nil
 }
 
-// Shared delete function for all access privileges
+// ClickHouse
+
+// ClickHouseGrant grants privileges on tables of one database. Database "*"
+// covers every database, and no Tables covers every table in Database.
+type ClickHouseGrant struct {
+	Privileges []string `json:"privileges"`
+	Database   string   `json:"database"`
+	Tables     []string `json:"tables,omitempty"`
+}
+
+// ClickHouseRowPolicy limits the rows of a table the short-lived users can
+// read to those matching Condition, a ClickHouse boolean expression.
+type ClickHouseRowPolicy struct {
+	Database  string `json:"database"`
+	Table     string `json:"table"`
+	Condition string `json:"condition"`
+}
+
+type ClickHouseAccessPrivilege struct {
+	ID              string                `json:"id,omitempty"`
+	Name            string                `json:"name"`
+	Description     string                `json:"description,omitempty"`
+	Type            string                `json:"type,omitempty"`
+	Grants          []ClickHouseGrant     `json:"grants"`
+	SettingsProfile string                `json:"settings_profile,omitempty"`
+	RowPolicies     []ClickHouseRowPolicy `json:"row_policies"`
+}
+
+type CreateClickHouseAccessPrivilegeInput struct {
+	Name            string                `json:"name"`
+	Description     string                `json:"description,omitempty"`
+	Grants          []ClickHouseGrant     `json:"grants"`
+	SettingsProfile string                `json:"settings_profile,omitempty"`
+	RowPolicies     []ClickHouseRowPolicy `json:"row_policies"`
+}
+
+type UpdateClickHouseAccessPrivilegeInput struct {
+	Name            *string                `json:"name,omitempty"`
+	Description     *string                `json:"description,omitempty"`
+	Grants          *[]ClickHouseGrant     `json:"grants,omitempty"`
+	SettingsProfile *nullableString        `json:"settings_profile,omitempty"`
+	RowPolicies     *[]ClickHouseRowPolicy `json:"row_policies,omitempty"`
+}
+
+func CreateClickHouseAccessPrivilege(ctx context.Context, c *Client, input *CreateClickHouseAccessPrivilegeInput) (*ClickHouseAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/clickhouse"
+	var resp ClickHouseAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetClickHouseAccessPrivilege(ctx context.Context, c *Client, id string) (*ClickHouseAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/clickhouse/%s", accessPrivilegesEndpoint, id)
+	var resp ClickHouseAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateClickHouseAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateClickHouseAccessPrivilegeInput) (*ClickHouseAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/clickhouse/%s", accessPrivilegesEndpoint, id)
+	var resp ClickHouseAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Cassandra
+
+// CassandraGrant grants permissions on one resource: every keyspace when
+// Keyspace is empty, one keyspace when Table is empty, or one table.
+type CassandraGrant struct {
+	Permissions []string `json:"permissions"`
+	Keyspace    string   `json:"keyspace,omitempty"`
+	Table       string   `json:"table,omitempty"`
+}
+
+type CassandraAccessPrivilege struct {
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Type        string           `json:"type,omitempty"`
+	Grants      []CassandraGrant `json:"grants"`
+}
+
+type CreateCassandraAccessPrivilegeInput struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Grants      []CassandraGrant `json:"grants"`
+}
+
+type UpdateCassandraAccessPrivilegeInput struct {
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Grants      *[]CassandraGrant `json:"grants,omitempty"`
+}
+
+func CreateCassandraAccessPrivilege(ctx context.Context, c *Client, input *CreateCassandraAccessPrivilegeInput) (*CassandraAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/cassandra"
+	var resp CassandraAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetCassandraAccessPrivilege(ctx context.Context, c *Client, id string) (*CassandraAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/cassandra/%s", accessPrivilegesEndpoint, id)
+	var resp CassandraAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateCassandraAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateCassandraAccessPrivilegeInput) (*CassandraAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/cassandra/%s", accessPrivilegesEndpoint, id)
+	var resp CassandraAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Anthropic
+
+type AnthropicPermission struct {
+	Name  string `json:"name"`
+	Level string `json:"level"`
+}
+
+// AnthropicRateLimit caps the usage of each minted API key, for one model or,
+// when Model is empty, for every model. Zero limits are unset.
+type AnthropicRateLimit struct {
+	Model                 string `json:"model,omitempty"`
+	RequestsPerMinute     int    `json:"requests_per_minute,omitempty"`
+	InputTokensPerMinute  int    `json:"input_tokens_per_minute,omitempty"`
+	OutputTokensPerMinute int    `json:"output_tokens_per_minute,omitempty"`
+}
+
+type AnthropicAccessPrivilege struct {
+	ID                   string                `json:"id,omitempty"`
+	Name                 string                `json:"name"`
+	Description          string                `json:"description,omitempty"`
+	Type                 string                `json:"type,omitempty"`
+	PermissionType       string                `json:"permission_type"`
+	Permissions          []AnthropicPermission `json:"permissions,omitempty"`
+	WorkspaceID          string                `json:"workspace_id,omitempty"`
+	MonthlySpendLimitUSD int                   `json:"monthly_spend_limit_usd,omitempty"`
+	RateLimits           []AnthropicRateLimit  `json:"rate_limits,omitempty"`
+}
+
+type CreateAnthropicAccessPrivilegeInput struct {
+	Name                 string                `json:"name"`
+	Description          string                `json:"description,omitempty"`
+	PermissionType       string                `json:"permission_type"`
+	Permissions          []AnthropicPermission `json:"permissions,omitempty"`
+	WorkspaceID          string                `json:"workspace_id,omitempty"`
+	MonthlySpendLimitUSD int                   `json:"monthly_spend_limit_usd,omitempty"`
+	RateLimits           []AnthropicRateLimit  `json:"rate_limits,omitempty"`
+}
+
+// UpdateAnthropicAccessPrivilegeInput sends a zero MonthlySpendLimitUSD or an
+// empty RateLimits to remove the limits.
+type UpdateAnthropicAccessPrivilegeInput struct {
+	Name                 *string                `json:"name,omitempty"`
+	Description          *string                `json:"description,omitempty"`
+	PermissionType       *string                `json:"permission_type,omitempty"`
+	Permissions          *[]AnthropicPermission `json:"permissions,omitempty"`
+	WorkspaceID          *nullableString        `json:"workspace_id,omitempty"`
+	MonthlySpendLimitUSD *int                   `json:"monthly_spend_limit_usd,omitempty"`
+	RateLimits           *[]AnthropicRateLimit  `json:"rate_limits,omitempty"`
+}
+
+func CreateAnthropicAccessPrivilege(ctx context.Context, c *Client, input *CreateAnthropicAccessPrivilegeInput) (*AnthropicAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/anthropic"
+	var resp AnthropicAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetAnthropicAccessPrivilege(ctx context.Context, c *Client, id string) (*AnthropicAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/anthropic/%s", accessPrivilegesEndpoint, id)
+	var resp AnthropicAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateAnthropicAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateAnthropicAccessPrivilegeInput) (*AnthropicAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/anthropic/%s", accessPrivilegesEndpoint, id)
+	var resp AnthropicAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Azure OpenAI
+
+// AzureOpenAIAccessPrivilege scopes minted identities to model deployments of
+// the credential's account. TokensPerMinute is a per-identity quota; zero is
+// unlimited.
+type AzureOpenAIAccessPrivilege struct {
+	ID              string   `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Type            string   `json:"type,omitempty"`
+	Deployments     []string `json:"deployments"`
+	TokensPerMinute int      `json:"tokens_per_minute,omitempty"`
+}
+
+type CreateAzureOpenAIAccessPrivilegeInput struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Deployments     []string `json:"deployments"`
+	TokensPerMinute int      `json:"tokens_per_minute,omitempty"`
+}
+
+// UpdateAzureOpenAIAccessPrivilegeInput sends a zero TokensPerMinute to remove
+// the quota.
+type UpdateAzureOpenAIAccessPrivilegeInput struct {
+	Name            *string   `json:"name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Deployments     *[]string `json:"deployments,omitempty"`
+	TokensPerMinute *int      `json:"tokens_per_minute,omitempty"`
+}
+
+func CreateAzureOpenAIAccessPrivilege(ctx context.Context, c *Client, input *CreateAzureOpenAIAccessPrivilegeInput) (*AzureOpenAIAccessPrivilege, error) {
+	path := accessPrivilegesEndpoint + "/azure_openai"
+	var resp AzureOpenAIAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetAzureOpenAIAccessPrivilege(ctx context.Context, c *Client, id string) (*AzureOpenAIAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/azure_openai/%s", accessPrivilegesEndpoint, id)
+	var resp AzureOpenAIAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateAzureOpenAIAccessPrivilege(ctx context.Context, c *Client, id string, input *UpdateAzureOpenAIAccessPrivilegeInput) (*AzureOpenAIAccessPrivilege, error) {
+	path := fmt.Sprintf("%s/azure_openai/%s", accessPrivilegesEndpoint, id)
+	var resp AzureOpenAIAccessPrivilege
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Shared functions for all access privileges
+
+// AccessPrivilege is the type-independent view of an access privilege returned
+// by the shared endpoint, enough to tell which credential type it applies to.
+type AccessPrivilege struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+func GetAccessPrivilege(ctx context.Context, c *Client, id string) (*AccessPrivilege, error) {
+	path := fmt.Sprintf("%s/%s", accessPrivilegesEndpoint, id)
+	var resp AccessPrivilege
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
 
 func DeleteAccessPrivilege(ctx context.Context, c *Client, id string) error {
 	path := fmt.Sprintf("%s/%s", accessPrivilegesEndpoint, id)
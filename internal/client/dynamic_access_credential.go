@@ -12,6 +12,8 @@ const (
 	AccessCredentialTypeMongoDBAtlas  AccessCredentialType = "mongodb_atlas"
 	AccessCredentialTypeMySQL         AccessCredentialType = "mysql"
 	AccessCredentialTypeMariaDB       AccessCredentialType = "mariadb"
+	AccessCredentialTypeMSSQL         AccessCredentialType = "mssql"
+	AccessCredentialTypeOracle        AccessCredentialType = "oracle"
 	AccessCredentialTypeOpenAI        AccessCredentialType = "openai"
 	AccessCredentialTypeGemini        AccessCredentialType = "gemini"
 	AccessCredentialTypeGrok          AccessCredentialType = "grok"
@@ -31,28 +33,43 @@ const (
 	AccessCredentialTypeGitlab        AccessCredentialType = "gitlab"
 	AccessCredentialTypeDatadog       AccessCredentialType = "datadog"
 	AccessCredentialTypeSalesforce    AccessCredentialType = "salesforce"
+	AccessCredentialTypeSendGrid      AccessCredentialType = "sendgrid"
 	AccessCredentialTypeTemporalCloud AccessCredentialType = "temporal_cloud"
 	AccessCredentialTypeKafka         AccessCredentialType = "kafka"
+	AccessCredentialTypeClickHouse    AccessCredentialType = "clickhouse"
+	AccessCredentialTypeCassandra     AccessCredentialType = "cassandra"
+	AccessCredentialTypeAnthropic     AccessCredentialType = "anthropic"
+	AccessCredentialTypeAzureOpenAI   AccessCredentialType = "azure_openai"
 )
 
 // Postgres
 
 type PostgresAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	DBName        string               `json:"db_name,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	Port          int                  `json:"port,omitempty"`
-	SSLMode       string               `json:"ssl_mode,omitempty"`
-	SSLCA         string               `json:"ssl_ca,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	DBName          string               `json:"db_name,omitempty"`
+	Host            string               `json:"host,omitempty"`
+	Port            int                  `json:"port,omitempty"`
+	SSLMode         string               `json:"ssl_mode,omitempty"`
+	SSLCA           string               `json:"ssl_ca,omitempty"`
+	Username        string               `json:"username,omitempty"`
+	Engine          string               `json:"engine,omitempty"`
+	// IAM engine fields.
+	Region                 string `json:"region,omitempty"`
+	AccessKeyID            string `json:"access_key_id,omitempty"`
+	InstanceConnectionName string `json:"instance_connection_name,omitempty"`
+	TenantID               string `json:"tenant_id,omitempty"`
+	ClientID               string `json:"client_id,omitempty"`
+
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
 }
 
 type CreatePostgresAccessCredentialInput struct {
@@ -66,7 +83,20 @@ type CreatePostgresAccessCredentialInput struct {
 	SSLMode       string   `json:"ssl_mode,omitempty"`
 	SSLCA         string   `json:"ssl_ca,omitempty"`
 	Username      string   `json:"username"`
-	Password      string   `json:"password"`
+	// password carries omitempty because the IAM engines authenticate with
+	// cloud identity and the API rejects a password on them.
+	Password string `json:"password,omitempty"`
+	Engine   string `json:"engine,omitempty"`
+	// IAM engine fields. Each engine's API credentials are optional: omitting
+	// them makes the access-manager use its own workload identity.
+	Region                 string `json:"region,omitempty"`
+	AccessKeyID            string `json:"access_key_id,omitempty"`
+	SecretAccessKey        string `json:"secret_access_key,omitempty"`
+	InstanceConnectionName string `json:"instance_connection_name,omitempty"`
+	ServiceAccountKey      string `json:"service_account_key,omitempty"`
+	TenantID               string `json:"tenant_id,omitempty"`
+	ClientID               string `json:"client_id,omitempty"`
+	ClientSecret           string `json:"client_secret,omitempty"`
 }
 
 type UpdatePostgresAccessCredentialInput struct {
@@ -80,6 +110,17 @@ type UpdatePostgresAccessCredentialInput struct {
 	SSLCA         *string              `json:"ssl_ca,omitempty"`
 	Username      *string              `json:"username,omitempty"`
 	Password      *string              `json:"password,omitempty"`
+	// IAM engine fields. engine is immutable and ignored by the API on update.
+	// The optional API credentials are nullable so that they can be dropped
+	// in favour of workload identity.
+	Region                 *string         `json:"region,omitempty"`
+	AccessKeyID            *nullableString `json:"access_key_id,omitempty"`
+	SecretAccessKey        *nullableString `json:"secret_access_key,omitempty"`
+	InstanceConnectionName *string         `json:"instance_connection_name,omitempty"`
+	ServiceAccountKey      *nullableString `json:"service_account_key,omitempty"`
+	TenantID               *string         `json:"tenant_id,omitempty"`
+	ClientID               *nullableString `json:"client_id,omitempty"`
+	ClientSecret           *nullableString `json:"client_secret,omitempty"`
 }
 
 func CreatePostgresAccessCredential(ctx context.Context, c *Client, input *CreatePostgresAccessCredentialInput) (*PostgresAccessCredential, error) {
@@ -122,22 +163,24 @@ func (p PostgresAccessCredential) statusFields() (string, string) {
 // MongoDB
 
 type MongoDBAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	DBName        string               `json:"db_name,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	Port          int                  `json:"port,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	AuthSource    string               `json:"auth_source,omitempty"`
-	TLS           bool                 `json:"tls,omitempty"`
-	TLSCA         string               `json:"tls_ca,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	DBName            string               `json:"db_name,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	Port              int                  `json:"port,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	AuthSource        string               `json:"auth_source,omitempty"`
+	TLS               bool                 `json:"tls,omitempty"`
+	TLSCA             string               `json:"tls_ca,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateMongoDBAccessCredentialInput struct {
@@ -209,20 +252,22 @@ func (m MongoDBAccessCredential) statusFields() (string, string) {
 // MongoDB Atlas
 
 type MongoDBAtlasAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	GroupID       string               `json:"group_id,omitempty"`
-	DBName        string               `json:"db_name,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	ClientID      string               `json:"client_id,omitempty"`
-	PublicKey     string               `json:"public_key,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	GroupID           string               `json:"group_id,omitempty"`
+	DBName            string               `json:"db_name,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	ClientID          string               `json:"client_id,omitempty"`
+	PublicKey         string               `json:"public_key,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateMongoDBAtlasAccessCredentialInput struct {
@@ -292,21 +337,31 @@ func (m MongoDBAtlasAccessCredential) statusFields() (string, string) {
 // MySQL
 
 type MySQLAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	DBName        string               `json:"db_name,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	Port          int                  `json:"port,omitempty"`
-	SSLMode       string               `json:"ssl_mode,omitempty"`
-	SSLCA         string               `json:"ssl_ca,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	DBName          string               `json:"db_name,omitempty"`
+	Host            string               `json:"host,omitempty"`
+	Port            int                  `json:"port,omitempty"`
+	SSLMode         string               `json:"ssl_mode,omitempty"`
+	SSLCA           string               `json:"ssl_ca,omitempty"`
+	Username        string               `json:"username,omitempty"`
+	Engine          string               `json:"engine,omitempty"`
+	// IAM engine fields.
+	Region                 string `json:"region,omitempty"`
+	AccessKeyID            string `json:"access_key_id,omitempty"`
+	InstanceConnectionName string `json:"instance_connection_name,omitempty"`
+	TenantID               string `json:"tenant_id,omitempty"`
+	ClientID               string `json:"client_id,omitempty"`
+
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateMySQLAccessCredentialInput struct {
@@ -320,7 +375,20 @@ type CreateMySQLAccessCredentialInput struct {
 	SSLMode       string   `json:"ssl_mode,omitempty"`
 	SSLCA         string   `json:"ssl_ca,omitempty"`
 	Username      string   `json:"username"`
-	Password      string   `json:"password"`
+	// password carries omitempty because the IAM engines authenticate with
+	// cloud identity and the API rejects a password on them.
+	Password string `json:"password,omitempty"`
+	Engine   string `json:"engine,omitempty"`
+	// IAM engine fields. Each engine's API credentials are optional: omitting
+	// them makes the access-manager use its own workload identity.
+	Region                 string `json:"region,omitempty"`
+	AccessKeyID            string `json:"access_key_id,omitempty"`
+	SecretAccessKey        string `json:"secret_access_key,omitempty"`
+	InstanceConnectionName string `json:"instance_connection_name,omitempty"`
+	ServiceAccountKey      string `json:"service_account_key,omitempty"`
+	TenantID               string `json:"tenant_id,omitempty"`
+	ClientID               string `json:"client_id,omitempty"`
+	ClientSecret           string `json:"client_secret,omitempty"`
 }
 
 type UpdateMySQLAccessCredentialInput struct {
@@ -334,6 +402,17 @@ type UpdateMySQLAccessCredentialInput struct {
 	SSLCA         *string              `json:"ssl_ca,omitempty"`
 	Username      *string              `json:"username,omitempty"`
 	Password      *string              `json:"password,omitempty"`
+	// IAM engine fields. engine is immutable and ignored by the API on update.
+	// The optional API credentials are nullable so that they can be dropped
+	// in favour of workload identity.
+	Region                 *string         `json:"region,omitempty"`
+	AccessKeyID            *nullableString `json:"access_key_id,omitempty"`
+	SecretAccessKey        *nullableString `json:"secret_access_key,omitempty"`
+	InstanceConnectionName *string         `json:"instance_connection_name,omitempty"`
+	ServiceAccountKey      *nullableString `json:"service_account_key,omitempty"`
+	TenantID               *string         `json:"tenant_id,omitempty"`
+	ClientID               *nullableString `json:"client_id,omitempty"`
+	ClientSecret           *nullableString `json:"client_secret,omitempty"`
 }
 
 func CreateMySQLAccessCredential(ctx context.Context, c *Client, input *CreateMySQLAccessCredentialInput) (*MySQLAccessCredential, error) {
@@ -376,21 +455,23 @@ func (m MySQLAccessCredential) statusFields() (string, string) {
 // MariaDB
 
 type MariaDBAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	DBName        string               `json:"db_name,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	Port          int                  `json:"port,omitempty"`
-	SSLMode       string               `json:"ssl_mode,omitempty"`
-	SSLCA         string               `json:"ssl_ca,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	DBName            string               `json:"db_name,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	Port              int                  `json:"port,omitempty"`
+	SSLMode           string               `json:"ssl_mode,omitempty"`
+	SSLCA             string               `json:"ssl_ca,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateMariaDBAccessCredentialInput struct {
@@ -457,28 +538,229 @@ func (m MariaDBAccessCredential) statusFields() (string, string) {
 	return m.Status, m.StatusDetail
 }
 
-// OpenAI
+// MSSQL
 
-type OpenAIAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	ProjectID     string               `json:"project_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+const (
+	MSSQLAuthMethodSQL     = "sql"
+	MSSQLAuthMethodEntraID = "entra_id"
+)
+
+type MSSQLAccessCredential struct {
+	ID                     string               `json:"id,omitempty"`
+	Name                   string               `json:"name"`
+	Description            string               `json:"description,omitempty"`
+	Type                   AccessCredentialType `json:"type"`
+	Kind                   string               `json:"kind,omitempty"`
+	DeploymentIDs          []string             `json:"deployment_ids"`
+	SecretStoreID          string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync        []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	DBName                 string               `json:"db_name,omitempty"`
+	Host                   string               `json:"host,omitempty"`
+	Port                   int                  `json:"port,omitempty"`
+	Instance               string               `json:"instance,omitempty"`
+	Encrypt                string               `json:"encrypt,omitempty"`
+	TrustServerCertificate bool                 `json:"trust_server_certificate,omitempty"`
+	AuthMethod             string               `json:"auth_method,omitempty"`
+	TenantID               string               `json:"tenant_id,omitempty"`
+	Username               string               `json:"username,omitempty"`
+	Status                 string               `json:"status,omitempty"`
+	StatusDetail           string               `json:"status_detail,omitempty"`
+	SecretFingerprint      string               `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateMSSQLAccessCredentialInput struct {
+	Name                   string   `json:"name"`
+	Description            string   `json:"description,omitempty"`
+	DeploymentIDs          []string `json:"deployment_ids"`
+	SecretStoreID          string   `json:"secret_store_id,omitempty"`
+	DBName                 string   `json:"db_name"`
+	Host                   string   `json:"host"`
+	Port                   int      `json:"port,omitempty"`
+	Instance               string   `json:"instance,omitempty"`
+	Encrypt                string   `json:"encrypt,omitempty"`
+	TrustServerCertificate bool     `json:"trust_server_certificate"`
+	AuthMethod             string   `json:"auth_method,omitempty"`
+	TenantID               string   `json:"tenant_id,omitempty"`
+	Username               string   `json:"username"`
+	Password               string   `json:"password"`
+}
+
+// UpdateMSSQLAccessCredentialInput clears instance and tenant_id when they
+// are sent as an empty string, so they go through nullableString.
+type UpdateMSSQLAccessCredentialInput struct {
+	Name                   *string              `json:"name,omitempty"`
+	Description            *string              `json:"description,omitempty"`
+	SecretStoreID          *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	DBName                 *string              `json:"db_name,omitempty"`
+	Host                   *string              `json:"host,omitempty"`
+	Port                   *int                 `json:"port,omitempty"`
+	Instance               *nullableString      `json:"instance,omitempty"`
+	Encrypt                *string              `json:"encrypt,omitempty"`
+	TrustServerCertificate *bool                `json:"trust_server_certificate,omitempty"`
+	AuthMethod             *string              `json:"auth_method,omitempty"`
+	TenantID               *nullableString      `json:"tenant_id,omitempty"`
+	Username               *string              `json:"username,omitempty"`
+	Password               *string              `json:"password,omitempty"`
+}
+
+func CreateMSSQLAccessCredential(ctx context.Context, c *Client, input *CreateMSSQLAccessCredentialInput) (*MSSQLAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/mssql"
+	var resp MSSQLAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetMSSQLAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
 
-type CreateOpenAIAccessCredentialInput struct {
+func GetMSSQLAccessCredential(ctx context.Context, c *Client, id string) (*MSSQLAccessCredential, error) {
+	path := fmt.Sprintf("%s/mssql/%s", accessCredentialsEndpoint, id)
+	var resp MSSQLAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateMSSQLAccessCredential(ctx context.Context, c *Client, id string, input *UpdateMSSQLAccessCredentialInput) (*MSSQLAccessCredential, error) {
+	path := fmt.Sprintf("%s/mssql/%s", accessCredentialsEndpoint, id)
+	var resp MSSQLAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetMSSQLAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m MSSQLAccessCredential) statusFields() (string, string) {
+	return m.Status, m.StatusDetail
+}
+
+// Oracle
+
+type OracleAccessCredential struct {
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	Port              int                  `json:"port,omitempty"`
+	ServiceName       string               `json:"service_name,omitempty"`
+	SID               string               `json:"sid,omitempty"`
+	TLS               bool                 `json:"tls,omitempty"`
+	SSLCA             string               `json:"ssl_ca,omitempty"`
+	HasWallet         bool                 `json:"has_wallet,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateOracleAccessCredentialInput struct {
 	Name          string   `json:"name"`
 	Description   string   `json:"description,omitempty"`
 	DeploymentIDs []string `json:"deployment_ids"`
 	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	APIKey        string   `json:"api_key"`
-	ProjectID     string   `json:"project_id,omitempty"`
+	Host          string   `json:"host"`
+	Port          int      `json:"port,omitempty"`
+	ServiceName   string   `json:"service_name,omitempty"`
+	SID           string   `json:"sid,omitempty"`
+	TLS           bool     `json:"tls"`
+	SSLCA         string   `json:"ssl_ca,omitempty"`
+	Wallet        string   `json:"wallet,omitempty"`
+	Username      string   `json:"username"`
+	Password      string   `json:"password"`
+}
+
+// UpdateOracleAccessCredentialInput clears service_name, sid, ssl_ca and
+// wallet when they are sent as an empty string, so switching between a
+// service name and a SID, or dropping the wallet, goes through nullableString.
+type UpdateOracleAccessCredentialInput struct {
+	Name          *string              `json:"name,omitempty"`
+	Description   *string              `json:"description,omitempty"`
+	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	Host          *string              `json:"host,omitempty"`
+	Port          *int                 `json:"port,omitempty"`
+	ServiceName   *nullableString      `json:"service_name,omitempty"`
+	SID           *nullableString      `json:"sid,omitempty"`
+	TLS           *bool                `json:"tls,omitempty"`
+	SSLCA         *nullableString      `json:"ssl_ca,omitempty"`
+	Wallet        *nullableString      `json:"wallet,omitempty"`
+	Username      *string              `json:"username,omitempty"`
+	Password      *string              `json:"password,omitempty"`
+}
+
+func CreateOracleAccessCredential(ctx context.Context, c *Client, input *CreateOracleAccessCredentialInput) (*OracleAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/oracle"
+	var resp OracleAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetOracleAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetOracleAccessCredential(ctx context.Context, c *Client, id string) (*OracleAccessCredential, error) {
+	path := fmt.Sprintf("%s/oracle/%s", accessCredentialsEndpoint, id)
+	var resp OracleAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateOracleAccessCredential(ctx context.Context, c *Client, id string, input *UpdateOracleAccessCredentialInput) (*OracleAccessCredential, error) {
+	path := fmt.Sprintf("%s/oracle/%s", accessCredentialsEndpoint, id)
+	var resp OracleAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetOracleAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (o OracleAccessCredential) statusFields() (string, string) {
+	return o.Status, o.StatusDetail
+}
+
+// OpenAI
+
+type OpenAIAccessCredential struct {
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	ProjectID         string                    `json:"project_id,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateOpenAIAccessCredentialInput struct {
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	APIKey        string                    `json:"api_key"`
+	ProjectID     string                    `json:"project_id,omitempty"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateOpenAIAccessCredentialInput struct {
@@ -487,6 +769,7 @@ type UpdateOpenAIAccessCredentialInput struct {
 	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
 	APIKey        *string              `json:"api_key,omitempty"`
 	ProjectID     *string              `json:"project_id,omitempty"`
+	Rotation      *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateOpenAIAccessCredential(ctx context.Context, c *Client, input *CreateOpenAIAccessCredentialInput) (*OpenAIAccessCredential, error) {
@@ -529,25 +812,29 @@ func (o OpenAIAccessCredential) statusFields() (string, string) {
 // Gemini
 
 type GeminiAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	ProjectID     string               `json:"project_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	ProjectID         string                    `json:"project_id,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateGeminiAccessCredentialInput struct {
-	Name              string   `json:"name"`
-	Description       string   `json:"description,omitempty"`
-	DeploymentIDs     []string `json:"deployment_ids"`
-	SecretStoreID     string   `json:"secret_store_id,omitempty"`
-	ServiceAccountKey string   `json:"service_account_key,omitempty"`
-	ProjectID         string   `json:"project_id"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	ServiceAccountKey string                    `json:"service_account_key,omitempty"`
+	ProjectID         string                    `json:"project_id"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateGeminiAccessCredentialInput struct {
@@ -556,6 +843,7 @@ type UpdateGeminiAccessCredentialInput struct {
 	SecretStoreID     *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
 	ServiceAccountKey *string              `json:"service_account_key,omitempty"`
 	ProjectID         *string              `json:"project_id,omitempty"`
+	Rotation          *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateGeminiAccessCredential(ctx context.Context, c *Client, input *CreateGeminiAccessCredentialInput) (*GeminiAccessCredential, error) {
@@ -598,16 +886,18 @@ func (g GeminiAccessCredential) statusFields() (string, string) {
 // Grok
 
 type GrokAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	TeamID        string               `json:"team_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	TeamID            string               `json:"team_id,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateGrokAccessCredentialInput struct {
@@ -674,6 +964,7 @@ type RedisAccessCredential struct {
 	Kind            string               `json:"kind,omitempty"`
 	DeploymentIDs   []string             `json:"deployment_ids"`
 	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
 	Host            string               `json:"host,omitempty"`
 	Port            int                  `json:"port,omitempty"`
 	Username        string               `json:"username,omitempty"`
@@ -696,8 +987,9 @@ type RedisAccessCredential struct {
 	ResourceGroup  string `json:"resource_group,omitempty"`
 	ClusterName    string `json:"cluster_name,omitempty"`
 
-	Status       string `json:"status,omitempty"`
-	StatusDetail string `json:"status_detail,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateRedisAccessCredentialInput struct {
@@ -814,11 +1106,13 @@ type BedrockAccessCredential struct {
 	Kind                   string               `json:"kind,omitempty"`
 	DeploymentIDs          []string             `json:"deployment_ids"`
 	SecretStoreID          string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync        []SecretStoreSync    `json:"secret_store_sync,omitempty"`
 	Region                 string               `json:"region"`
 	AccessKeyID            *string              `json:"access_key_id,omitempty"`
 	HasProviderCredentials bool                 `json:"has_provider_credentials"`
 	Status                 string               `json:"status,omitempty"`
 	StatusDetail           string               `json:"status_detail,omitempty"`
+	SecretFingerprint      string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateBedrockAccessCredentialInput struct {
@@ -887,9 +1181,11 @@ type ApigeeAccessCredential struct {
 	Kind                   string               `json:"kind,omitempty"`
 	DeploymentIDs          []string             `json:"deployment_ids"`
 	SecretStoreID          string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync        []SecretStoreSync    `json:"secret_store_sync,omitempty"`
 	HasProviderCredentials bool                 `json:"has_provider_credentials"`
 	Status                 string               `json:"status,omitempty"`
 	StatusDetail           string               `json:"status_detail,omitempty"`
+	SecretFingerprint      string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateApigeeAccessCredentialInput struct {
@@ -947,20 +1243,22 @@ func (a ApigeeAccessCredential) statusFields() (string, string) {
 // Elasticsearch
 
 type ElasticsearchAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Host          string               `json:"host,omitempty"`
-	Port          int                  `json:"port,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	TLS           bool                 `json:"tls,omitempty"`
-	TLSCA         string               `json:"tls_ca,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	Port              int                  `json:"port,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	TLS               bool                 `json:"tls,omitempty"`
+	TLSCA             string               `json:"tls_ca,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateElasticsearchAccessCredentialInput struct {
@@ -1028,23 +1326,25 @@ func (e ElasticsearchAccessCredential) statusFields() (string, string) {
 // RabbitMQ
 
 type RabbitmqAccessCredential struct {
-	ID             string               `json:"id,omitempty"`
-	Name           string               `json:"name"`
-	Description    string               `json:"description,omitempty"`
-	Type           AccessCredentialType `json:"type"`
-	Kind           string               `json:"kind,omitempty"`
-	DeploymentIDs  []string             `json:"deployment_ids"`
-	SecretStoreID  string               `json:"secret_store_id,omitempty"`
-	Host           string               `json:"host,omitempty"`
-	Port           int                  `json:"port,omitempty"`
-	ManagementPort int                  `json:"management_port,omitempty"`
-	Username       string               `json:"username,omitempty"`
-	Vhost          string               `json:"vhost,omitempty"`
-	TLS            bool                 `json:"tls,omitempty"`
-	TLSCA          string               `json:"tls_ca,omitempty"`
-	AutoRotateRoot bool                 `json:"auto_rotate_root"`
-	Status         string               `json:"status,omitempty"`
-	StatusDetail   string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Host              string               `json:"host,omitempty"`
+	Port              int                  `json:"port,omitempty"`
+	ManagementPort    int                  `json:"management_port,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	Vhost             string               `json:"vhost,omitempty"`
+	TLS               bool                 `json:"tls,omitempty"`
+	TLSCA             string               `json:"tls_ca,omitempty"`
+	AutoRotateRoot    bool                 `json:"auto_rotate_root"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateRabbitmqAccessCredentialInput struct {
@@ -1118,15 +1418,17 @@ func (r RabbitmqAccessCredential) statusFields() (string, string) {
 // GCP SA
 
 type GCPSAAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateGCPSAAccessCredentialInput struct {
@@ -1184,17 +1486,19 @@ func (g GCPSAAccessCredential) statusFields() (string, string) {
 // Azure App
 
 type AzureAppAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	TenantID      string               `json:"tenant_id,omitempty"`
-	ClientID      string               `json:"client_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	TenantID          string               `json:"tenant_id,omitempty"`
+	ClientID          string               `json:"client_id,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateAzureAppAccessCredentialInput struct {
@@ -1263,10 +1567,12 @@ type AWSAccessKeyAccessCredential struct {
 	Kind               string               `json:"kind,omitempty"`
 	DeploymentIDs      []string             `json:"deployment_ids"`
 	SecretStoreID      string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync    []SecretStoreSync    `json:"secret_store_sync,omitempty"`
 	AccessKeyID        string               `json:"access_key_id,omitempty"`
 	PermissionBoundary bool                 `json:"permission_boundary,omitempty"`
 	Status             string               `json:"status,omitempty"`
 	StatusDetail       string               `json:"status_detail,omitempty"`
+	SecretFingerprint  string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateAWSAccessKeyAccessCredentialInput struct {
@@ -1328,27 +1634,31 @@ func (a AWSAccessKeyAccessCredential) statusFields() (string, string) {
 // Twilio
 
 type TwilioAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	AccountSID    string               `json:"account_sid"`
-	APIKeySID     string               `json:"api_key_sid"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	AccountSID        string                    `json:"account_sid"`
+	APIKeySID         string                    `json:"api_key_sid"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateTwilioAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	AccountSID    string   `json:"account_sid"`
-	APIKeySID     string   `json:"api_key_sid"`
-	APIKeySecret  string   `json:"api_key_secret"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	AccountSID    string                    `json:"account_sid"`
+	APIKeySID     string                    `json:"api_key_sid"`
+	APIKeySecret  string                    `json:"api_key_secret"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateTwilioAccessCredentialInput struct {
@@ -1358,6 +1668,7 @@ type UpdateTwilioAccessCredentialInput struct {
 	AccountSID    *string              `json:"account_sid,omitempty"`
 	APIKeySID     *string              `json:"api_key_sid,omitempty"`
 	APIKeySecret  *string              `json:"api_key_secret,omitempty"`
+	Rotation      *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateTwilioAccessCredential(ctx context.Context, c *Client, input *CreateTwilioAccessCredentialInput) (*TwilioAccessCredential, error) {
@@ -1400,22 +1711,24 @@ func (t TwilioAccessCredential) statusFields() (string, string) {
 // Snowflake
 
 type SnowflakeAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Account       string               `json:"account,omitempty"`
-	Warehouse     string               `json:"warehouse,omitempty"`
-	Database      string               `json:"database,omitempty"`
-	Schema        string               `json:"schema,omitempty"`
-	Role          string               `json:"role,omitempty"`
-	Username      string               `json:"username,omitempty"`
-	AuthMethod    string               `json:"auth_method,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Account           string               `json:"account,omitempty"`
+	Warehouse         string               `json:"warehouse,omitempty"`
+	Database          string               `json:"database,omitempty"`
+	Schema            string               `json:"schema,omitempty"`
+	Role              string               `json:"role,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	AuthMethod        string               `json:"auth_method,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateSnowflakeAccessCredentialInput struct {
@@ -1489,17 +1802,18 @@ func (s SnowflakeAccessCredential) statusFields() (string, string) {
 // AWS WIF
 
 type AwsWifAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Audience      string               `json:"audience,omitempty"`
-	IssuerURL     string               `json:"issuer_url,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Audience        string               `json:"audience,omitempty"`
+	IssuerURL       string               `json:"issuer_url,omitempty"`
+	Status          string               `json:"status,omitempty"`
+	StatusDetail    string               `json:"status_detail,omitempty"`
 }
 
 type CreateAwsWifAccessCredentialInput struct {
@@ -1556,18 +1870,20 @@ func (a AwsWifAccessCredential) statusFields() (string, string) {
 // Gitlab
 
 type GitlabAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	BaseURL       string               `json:"base_url"`
-	ResourceType  string               `json:"resource_type"`
-	ResourceID    string               `json:"resource_id"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	BaseURL           string               `json:"base_url"`
+	ResourceType      string               `json:"resource_type"`
+	ResourceID        string               `json:"resource_id"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateGitlabAccessCredentialInput struct {
@@ -1638,6 +1954,7 @@ type GcpWifAccessCredential struct {
 	Kind               string               `json:"kind,omitempty"`
 	DeploymentIDs      []string             `json:"deployment_ids"`
 	SecretStoreID      string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync    []SecretStoreSync    `json:"secret_store_sync,omitempty"`
 	ProjectNumber      string               `json:"project_number,omitempty"`
 	PoolID             string               `json:"pool_id,omitempty"`
 	WorkloadProviderID string               `json:"workload_provider_id,omitempty"`
@@ -1709,17 +2026,18 @@ func (g GcpWifAccessCredential) statusFields() (string, string) {
 // Azure WIF
 
 type AzureWifAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Audience      string               `json:"audience,omitempty"`
-	IssuerURL     string               `json:"issuer_url,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Audience        string               `json:"audience,omitempty"`
+	IssuerURL       string               `json:"issuer_url,omitempty"`
+	Status          string               `json:"status,omitempty"`
+	StatusDetail    string               `json:"status_detail,omitempty"`
 }
 
 type CreateAzureWifAccessCredentialInput struct {
@@ -1776,26 +2094,30 @@ func (a AzureWifAccessCredential) statusFields() (string, string) {
 // Datadog
 
 type DatadogAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Site          string               `json:"site,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	Site              string                    `json:"site,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateDatadogAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	APIKey        string   `json:"api_key"`
-	AppKey        string   `json:"app_key,omitempty"`
-	Site          string   `json:"site,omitempty"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	APIKey        string                    `json:"api_key"`
+	AppKey        string                    `json:"app_key,omitempty"`
+	Site          string                    `json:"site,omitempty"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateDatadogAccessCredentialInput struct {
@@ -1805,6 +2127,7 @@ type UpdateDatadogAccessCredentialInput struct {
 	APIKey        *string              `json:"api_key,omitempty"`
 	AppKey        *string              `json:"app_key,omitempty"`
 	Site          *string              `json:"site,omitempty"`
+	Rotation      *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateDatadogAccessCredential(ctx context.Context, c *Client, input *CreateDatadogAccessCredentialInput) (*DatadogAccessCredential, error) {
@@ -1847,17 +2170,19 @@ func (d DatadogAccessCredential) statusFields() (string, string) {
 // Salesforce
 
 type SalesforceAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	InstanceURL   string               `json:"instance_url"`
-	ClientID      string               `json:"client_id"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	InstanceURL       string               `json:"instance_url"`
+	ClientID          string               `json:"client_id"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateSalesforceAccessCredentialInput struct {
@@ -1919,23 +2244,27 @@ func (s SalesforceAccessCredential) statusFields() (string, string) {
 // SendGrid
 
 type SendGridAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateSendGridAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	APIKey        string   `json:"api_key"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	APIKey        string                    `json:"api_key"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateSendGridAccessCredentialInput struct {
@@ -1943,6 +2272,7 @@ type UpdateSendGridAccessCredentialInput struct {
 	Description   *string              `json:"description,omitempty"`
 	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
 	APIKey        *string              `json:"api_key,omitempty"`
+	Rotation      *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateSendGridAccessCredential(ctx context.Context, c *Client, input *CreateSendGridAccessCredentialInput) (*SendGridAccessCredential, error) {
@@ -1985,23 +2315,27 @@ func (s SendGridAccessCredential) statusFields() (string, string) {
 // Temporal Cloud
 
 type TemporalCloudAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Status        string               `json:"status,omitempty"`
-	StatusDetail  string               `json:"status_detail,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateTemporalCloudAccessCredentialInput struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	DeploymentIDs []string `json:"deployment_ids"`
-	SecretStoreID string   `json:"secret_store_id,omitempty"`
-	APIKey        string   `json:"api_key"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	DeploymentIDs []string                  `json:"deployment_ids"`
+	SecretStoreID string                    `json:"secret_store_id,omitempty"`
+	APIKey        string                    `json:"api_key"`
+	Rotation      *AccessCredentialRotation `json:"rotation,omitempty"`
 }
 
 type UpdateTemporalCloudAccessCredentialInput struct {
@@ -2009,6 +2343,7 @@ type UpdateTemporalCloudAccessCredentialInput struct {
 	Description   *string              `json:"description,omitempty"`
 	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
 	APIKey        *string              `json:"api_key,omitempty"`
+	Rotation      *rotationUpdate      `json:"rotation,omitempty"`
 }
 
 func CreateTemporalCloudAccessCredential(ctx context.Context, c *Client, input *CreateTemporalCloudAccessCredentialInput) (*TemporalCloudAccessCredential, error) {
@@ -2051,14 +2386,15 @@ func (t TemporalCloudAccessCredential) statusFields() (string, string) {
 // Kafka
 
 type KafkaAccessCredential struct {
-	ID            string               `json:"id,omitempty"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description,omitempty"`
-	Type          AccessCredentialType `json:"type"`
-	Kind          string               `json:"kind,omitempty"`
-	DeploymentIDs []string             `json:"deployment_ids"`
-	SecretStoreID string               `json:"secret_store_id,omitempty"`
-	Engine        string               `json:"engine,omitempty"`
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Engine          string               `json:"engine,omitempty"`
 	// Native-engine fields.
 	BootstrapServers string `json:"bootstrap_servers,omitempty"`
 	Username         string `json:"username,omitempty"`
@@ -2066,10 +2402,20 @@ type KafkaAccessCredential struct {
 	TLS              bool   `json:"tls,omitempty"`
 	TLSCA            string `json:"tls_ca,omitempty"`
 	// Aiven-engine fields.
-	Project      string `json:"project,omitempty"`
-	ServiceName  string `json:"service_name,omitempty"`
-	Status       string `json:"status,omitempty"`
-	StatusDetail string `json:"status_detail,omitempty"`
+	Project     string `json:"project,omitempty"`
+	ServiceName string `json:"service_name,omitempty"`
+	// Confluent Cloud-engine fields.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	ClusterID     string `json:"cluster_id,omitempty"`
+	CloudAPIKey   string `json:"cloud_api_key,omitempty"`
+	// MSK IAM-engine fields.
+	ClusterARN string `json:"cluster_arn,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RoleARN    string `json:"role_arn,omitempty"`
+
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
 }
 
 type CreateKafkaAccessCredentialInput struct {
@@ -2089,6 +2435,15 @@ type CreateKafkaAccessCredentialInput struct {
 	Project     string `json:"project,omitempty"`
 	ServiceName string `json:"service_name,omitempty"`
 	Token       string `json:"token,omitempty"`
+	// Confluent Cloud-engine fields.
+	EnvironmentID  string `json:"environment_id,omitempty"`
+	ClusterID      string `json:"cluster_id,omitempty"`
+	CloudAPIKey    string `json:"cloud_api_key,omitempty"`
+	CloudAPISecret string `json:"cloud_api_secret,omitempty"`
+	// MSK IAM-engine fields.
+	ClusterARN string `json:"cluster_arn,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RoleARN    string `json:"role_arn,omitempty"`
 }
 
 // UpdateKafkaAccessCredentialInput omits engine: it is immutable and ignored by
@@ -2108,6 +2463,15 @@ type UpdateKafkaAccessCredentialInput struct {
 	Project     *string `json:"project,omitempty"`
 	ServiceName *string `json:"service_name,omitempty"`
 	Token       *string `json:"token,omitempty"`
+	// Confluent Cloud-engine fields.
+	EnvironmentID  *string `json:"environment_id,omitempty"`
+	ClusterID      *string `json:"cluster_id,omitempty"`
+	CloudAPIKey    *string `json:"cloud_api_key,omitempty"`
+	CloudAPISecret *string `json:"cloud_api_secret,omitempty"`
+	// MSK IAM-engine fields.
+	ClusterARN *string `json:"cluster_arn,omitempty"`
+	Region     *string `json:"region,omitempty"`
+	RoleARN    *string `json:"role_arn,omitempty"`
 }
 
 func CreateKafkaAccessCredential(ctx context.Context, c *Client, input *CreateKafkaAccessCredentialInput) (*KafkaAccessCredential, error) {
@@ -2146,3 +2510,379 @@ func UpdateKafkaAccessCredential(ctx context.Context, c *Client, id string, inpu
 func (k KafkaAccessCredential) statusFields() (string, string) {
 	return k.Status, k.StatusDetail
 }
+
+// ClickHouse
+
+type ClickHouseAccessCredential struct {
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Engine          string               `json:"engine,omitempty"`
+	// Native-engine fields.
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	TLS      bool   `json:"tls,omitempty"`
+	TLSCA    string `json:"tls_ca,omitempty"`
+	Cluster  string `json:"cluster,omitempty"`
+	Username string `json:"username,omitempty"`
+	// ClickHouse Cloud-engine fields.
+	OrganizationID    string `json:"organization_id,omitempty"`
+	ServiceID         string `json:"service_id,omitempty"`
+	KeyID             string `json:"key_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateClickHouseAccessCredentialInput struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	DeploymentIDs []string `json:"deployment_ids"`
+	SecretStoreID string   `json:"secret_store_id,omitempty"`
+	Engine        string   `json:"engine"`
+	// Native-engine fields.
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	TLS      bool   `json:"tls,omitempty"`
+	TLSCA    string `json:"tls_ca,omitempty"`
+	Cluster  string `json:"cluster,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// ClickHouse Cloud-engine fields.
+	OrganizationID string `json:"organization_id,omitempty"`
+	ServiceID      string `json:"service_id,omitempty"`
+	KeyID          string `json:"key_id,omitempty"`
+	KeySecret      string `json:"key_secret,omitempty"`
+}
+
+// UpdateClickHouseAccessCredentialInput omits engine: it is immutable and
+// ignored by the API on update.
+type UpdateClickHouseAccessCredentialInput struct {
+	Name          *string              `json:"name,omitempty"`
+	Description   *string              `json:"description,omitempty"`
+	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	// Native-engine fields.
+	Host     *string         `json:"host,omitempty"`
+	Port     *int            `json:"port,omitempty"`
+	TLS      *bool           `json:"tls,omitempty"`
+	TLSCA    *string         `json:"tls_ca,omitempty"`
+	Cluster  *nullableString `json:"cluster,omitempty"`
+	Username *string         `json:"username,omitempty"`
+	Password *string         `json:"password,omitempty"`
+	// ClickHouse Cloud-engine fields.
+	OrganizationID *string `json:"organization_id,omitempty"`
+	ServiceID      *string `json:"service_id,omitempty"`
+	KeyID          *string `json:"key_id,omitempty"`
+	KeySecret      *string `json:"key_secret,omitempty"`
+}
+
+func CreateClickHouseAccessCredential(ctx context.Context, c *Client, input *CreateClickHouseAccessCredentialInput) (*ClickHouseAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/clickhouse"
+	var resp ClickHouseAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetClickHouseAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetClickHouseAccessCredential(ctx context.Context, c *Client, id string) (*ClickHouseAccessCredential, error) {
+	path := fmt.Sprintf("%s/clickhouse/%s", accessCredentialsEndpoint, id)
+	var resp ClickHouseAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateClickHouseAccessCredential(ctx context.Context, c *Client, id string, input *UpdateClickHouseAccessCredentialInput) (*ClickHouseAccessCredential, error) {
+	path := fmt.Sprintf("%s/clickhouse/%s", accessCredentialsEndpoint, id)
+	var resp ClickHouseAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetClickHouseAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (ch ClickHouseAccessCredential) statusFields() (string, string) {
+	return ch.Status, ch.StatusDetail
+}
+
+// Cassandra
+
+type CassandraAccessCredential struct {
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description,omitempty"`
+	Type            AccessCredentialType `json:"type"`
+	Kind            string               `json:"kind,omitempty"`
+	DeploymentIDs   []string             `json:"deployment_ids"`
+	SecretStoreID   string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	Engine          string               `json:"engine,omitempty"`
+	// Cassandra and Scylla engine fields.
+	Hosts           []string `json:"hosts,omitempty"`
+	Port            int      `json:"port,omitempty"`
+	LocalDatacenter string   `json:"local_datacenter,omitempty"`
+	TLS             bool     `json:"tls,omitempty"`
+	TLSCA           string   `json:"tls_ca,omitempty"`
+	Username        string   `json:"username,omitempty"`
+	// Keyspaces-engine fields.
+	Region            string `json:"region,omitempty"`
+	AccessKeyID       string `json:"access_key_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	SecretFingerprint string `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateCassandraAccessCredentialInput struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	DeploymentIDs []string `json:"deployment_ids"`
+	SecretStoreID string   `json:"secret_store_id,omitempty"`
+	Engine        string   `json:"engine"`
+	// Cassandra and Scylla engine fields.
+	Hosts           []string `json:"hosts,omitempty"`
+	Port            int      `json:"port,omitempty"`
+	LocalDatacenter string   `json:"local_datacenter,omitempty"`
+	TLS             bool     `json:"tls,omitempty"`
+	TLSCA           string   `json:"tls_ca,omitempty"`
+	Username        string   `json:"username,omitempty"`
+	Password        string   `json:"password,omitempty"`
+	// Keyspaces-engine fields. access_key_id/secret_access_key are optional:
+	// omitting both makes the access-manager sign requests with its own AWS
+	// workload identity.
+	Region          string `json:"region,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+}
+
+// UpdateCassandraAccessCredentialInput omits engine: it is immutable and
+// ignored by the API on update.
+type UpdateCassandraAccessCredentialInput struct {
+	Name          *string              `json:"name,omitempty"`
+	Description   *string              `json:"description,omitempty"`
+	SecretStoreID *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	// Cassandra and Scylla engine fields.
+	Hosts           *[]string       `json:"hosts,omitempty"`
+	Port            *int            `json:"port,omitempty"`
+	LocalDatacenter *nullableString `json:"local_datacenter,omitempty"`
+	TLS             *bool           `json:"tls,omitempty"`
+	TLSCA           *string         `json:"tls_ca,omitempty"`
+	Username        *string         `json:"username,omitempty"`
+	Password        *string         `json:"password,omitempty"`
+	// Keyspaces-engine fields.
+	Region          *string `json:"region,omitempty"`
+	AccessKeyID     *string `json:"access_key_id,omitempty"`
+	SecretAccessKey *string `json:"secret_access_key,omitempty"`
+}
+
+func CreateCassandraAccessCredential(ctx context.Context, c *Client, input *CreateCassandraAccessCredentialInput) (*CassandraAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/cassandra"
+	var resp CassandraAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetCassandraAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetCassandraAccessCredential(ctx context.Context, c *Client, id string) (*CassandraAccessCredential, error) {
+	path := fmt.Sprintf("%s/cassandra/%s", accessCredentialsEndpoint, id)
+	var resp CassandraAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateCassandraAccessCredential(ctx context.Context, c *Client, id string, input *UpdateCassandraAccessCredentialInput) (*CassandraAccessCredential, error) {
+	path := fmt.Sprintf("%s/cassandra/%s", accessCredentialsEndpoint, id)
+	var resp CassandraAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetCassandraAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (ca CassandraAccessCredential) statusFields() (string, string) {
+	return ca.Status, ca.StatusDetail
+}
+
+// Anthropic
+
+type AnthropicAccessCredential struct {
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description,omitempty"`
+	Type              AccessCredentialType      `json:"type"`
+	Kind              string                    `json:"kind,omitempty"`
+	DeploymentIDs     []string                  `json:"deployment_ids"`
+	SecretStoreID     string                    `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync         `json:"secret_store_sync,omitempty"`
+	OrganizationID    string                    `json:"organization_id,omitempty"`
+	WorkspaceID       string                    `json:"workspace_id,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDetail      string                    `json:"status_detail,omitempty"`
+	Rotation          *AccessCredentialRotation `json:"rotation,omitempty"`
+	SecretFingerprint string                    `json:"secret_fingerprint,omitempty"`
+}
+
+type CreateAnthropicAccessCredentialInput struct {
+	Name           string                    `json:"name"`
+	Description    string                    `json:"description,omitempty"`
+	DeploymentIDs  []string                  `json:"deployment_ids"`
+	SecretStoreID  string                    `json:"secret_store_id,omitempty"`
+	AdminAPIKey    string                    `json:"admin_api_key"`
+	OrganizationID string                    `json:"organization_id,omitempty"`
+	WorkspaceID    string                    `json:"workspace_id,omitempty"`
+	Rotation       *AccessCredentialRotation `json:"rotation,omitempty"`
+}
+
+type UpdateAnthropicAccessCredentialInput struct {
+	Name           *string              `json:"name,omitempty"`
+	Description    *string              `json:"description,omitempty"`
+	SecretStoreID  *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	AdminAPIKey    *string              `json:"admin_api_key,omitempty"`
+	OrganizationID *nullableString      `json:"organization_id,omitempty"`
+	WorkspaceID    *nullableString      `json:"workspace_id,omitempty"`
+	Rotation       *rotationUpdate      `json:"rotation,omitempty"`
+}
+
+func CreateAnthropicAccessCredential(ctx context.Context, c *Client, input *CreateAnthropicAccessCredentialInput) (*AnthropicAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/anthropic"
+	var resp AnthropicAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetAnthropicAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetAnthropicAccessCredential(ctx context.Context, c *Client, id string) (*AnthropicAccessCredential, error) {
+	path := fmt.Sprintf("%s/anthropic/%s", accessCredentialsEndpoint, id)
+	var resp AnthropicAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateAnthropicAccessCredential(ctx context.Context, c *Client, id string, input *UpdateAnthropicAccessCredentialInput) (*AnthropicAccessCredential, error) {
+	path := fmt.Sprintf("%s/anthropic/%s", accessCredentialsEndpoint, id)
+	var resp AnthropicAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetAnthropicAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (a AnthropicAccessCredential) statusFields() (string, string) {
+	return a.Status, a.StatusDetail
+}
+
+// Azure OpenAI
+
+type AzureOpenAIAccessCredential struct {
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name"`
+	Description       string               `json:"description,omitempty"`
+	Type              AccessCredentialType `json:"type"`
+	Kind              string               `json:"kind,omitempty"`
+	DeploymentIDs     []string             `json:"deployment_ids"`
+	SecretStoreID     string               `json:"secret_store_id,omitempty"`
+	SecretStoreSync   []SecretStoreSync    `json:"secret_store_sync,omitempty"`
+	TenantID          string               `json:"tenant_id"`
+	SubscriptionID    string               `json:"subscription_id"`
+	ResourceGroup     string               `json:"resource_group"`
+	AccountName       string               `json:"account_name"`
+	ClientID          string               `json:"client_id,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDetail      string               `json:"status_detail,omitempty"`
+	SecretFingerprint string               `json:"secret_fingerprint,omitempty"`
+}
+
+// CreateAzureOpenAIAccessCredentialInput leaves client_id/client_secret
+// optional: omitting both makes the access-manager use its default Azure
+// credentials (managed identity / workload identity).
+type CreateAzureOpenAIAccessCredentialInput struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	DeploymentIDs  []string `json:"deployment_ids"`
+	SecretStoreID  string   `json:"secret_store_id,omitempty"`
+	TenantID       string   `json:"tenant_id"`
+	SubscriptionID string   `json:"subscription_id"`
+	ResourceGroup  string   `json:"resource_group"`
+	AccountName    string   `json:"account_name"`
+	ClientID       string   `json:"client_id,omitempty"`
+	ClientSecret   string   `json:"client_secret,omitempty"`
+}
+
+type UpdateAzureOpenAIAccessCredentialInput struct {
+	Name           *string              `json:"name,omitempty"`
+	Description    *string              `json:"description,omitempty"`
+	SecretStoreID  *secretStoreIDUpdate `json:"secret_store_id,omitempty"`
+	TenantID       *string              `json:"tenant_id,omitempty"`
+	SubscriptionID *string              `json:"subscription_id,omitempty"`
+	ResourceGroup  *string              `json:"resource_group,omitempty"`
+	AccountName    *string              `json:"account_name,omitempty"`
+	ClientID       *nullableString      `json:"client_id,omitempty"`
+	ClientSecret   *nullableString      `json:"client_secret,omitempty"`
+}
+
+func CreateAzureOpenAIAccessCredential(ctx context.Context, c *Client, input *CreateAzureOpenAIAccessCredentialInput) (*AzureOpenAIAccessCredential, error) {
+	path := accessCredentialsEndpoint + "/azure_openai"
+	var resp AzureOpenAIAccessCredential
+	if err := c.doRequest(ctx, http.MethodPost, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, resp.ID, GetAzureOpenAIAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetAzureOpenAIAccessCredential(ctx context.Context, c *Client, id string) (*AzureOpenAIAccessCredential, error) {
+	path := fmt.Sprintf("%s/azure_openai/%s", accessCredentialsEndpoint, id)
+	var resp AzureOpenAIAccessCredential
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func UpdateAzureOpenAIAccessCredential(ctx context.Context, c *Client, id string, input *UpdateAzureOpenAIAccessCredentialInput) (*AzureOpenAIAccessCredential, error) {
+	path := fmt.Sprintf("%s/azure_openai/%s", accessCredentialsEndpoint, id)
+	var resp AzureOpenAIAccessCredential
+	if err := c.doRequest(ctx, http.MethodPatch, path, input, &resp); err != nil {
+		return nil, err
+	}
+	if err := waitForResourceStatus(ctx, c, id, GetAzureOpenAIAccessCredential); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (a AzureOpenAIAccessCredential) statusFields() (string, string) {
+	return a.Status, a.StatusDetail
+}
@@ -50,6 +50,11 @@ func (e *APIError) IsConflict() bool {
 	return e.StatusCode == http.StatusConflict
 }
 
+// IsPreconditionFailed returns true if the error is a 412 Precondition Failed
+func (e *APIError) IsPreconditionFailed() bool {
+	return e.StatusCode == http.StatusPreconditionFailed
+}
+
 // ParseErrorResponse parses the HTTP response into a simple error
 func ParseErrorResponse(resp *http.Response, method, url string) error {
 	defer func() {
@@ -109,3 +114,10 @@ func IsConflictError(err error) bool {
 	}
 	return false
 }
+
+func IsPreconditionFailedError(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.IsPreconditionFailed()
+	}
+	return false
+}
@@ -15,6 +15,14 @@ const (
 	SecretStoreKindAWSSSM     = "aws_ssm"
 	SecretStoreKindGCPSM      = "gcp_sm"
 	SecretStoreKindK8sSecrets = "k8s_secrets"
+	SecretStoreKindAzureKV    = "azure_kv"
+	SecretStoreKindVault      = "vault"
+)
+
+// Vault auth methods the access-manager can log in with.
+const (
+	VaultAuthMethodKubernetes = "kubernetes"
+	VaultAuthMethodAppRole    = "approle"
 )
 
 // SecretStoreConfig is the backend's discriminated config union flattened into a
@@ -27,7 +35,26 @@ type SecretStoreConfig struct {
 	Region    string `json:"region,omitempty"`
 	KmsKeyID  string `json:"kms_key_id,omitempty"`
 	ProjectID string `json:"project_id,omitempty"`
+	// Namespace is the Kubernetes namespace for k8s_secrets and the Vault
+	// Enterprise namespace for vault.
 	Namespace string `json:"namespace,omitempty"`
+
+	// azure_kv fields. ManagedIdentityClientID selects a user-assigned
+	// identity; when empty the access-manager's default Azure credentials are
+	// used.
+	VaultURL                string `json:"vault_url,omitempty"`
+	TenantID                string `json:"tenant_id,omitempty"`
+	ManagedIdentityClientID string `json:"managed_identity_client_id,omitempty"`
+
+	// vault fields. Role is the Kubernetes auth role; RoleID and SecretID are
+	// the AppRole credentials. SecretID is only sent, never returned.
+	Address    string `json:"address,omitempty"`
+	Mount      string `json:"mount,omitempty"`
+	AuthMethod string `json:"auth_method,omitempty"`
+	AuthMount  string `json:"auth_mount,omitempty"`
+	Role       string `json:"role,omitempty"`
+	RoleID     string `json:"role_id,omitempty"`
+	SecretID   string `json:"secret_id,omitempty"`
 }
 
 type SecretStore struct {
@@ -51,13 +78,31 @@ type CreateSecretStoreInput struct {
 	Config        SecretStoreConfig `json:"config"`
 }
 
-// UpdateSecretStoreInput carries only the mutable fields. Config is immutable
-// server-side, so it is absent here. DeploymentIDs is a pointer so an empty list
-// (clearing all associations) can be distinguished from "leave unchanged".
+// UpdateSecretStoreInput carries only the mutable fields. DeploymentIDs is a
+// pointer so an empty list (clearing all associations) can be distinguished
+// from "leave unchanged".
 type UpdateSecretStoreInput struct {
-	Name          *string   `json:"name,omitempty"`
-	Description   *string   `json:"description,omitempty"`
-	DeploymentIDs *[]string `json:"deployment_ids,omitempty"`
+	Name          *string                  `json:"name,omitempty"`
+	Description   *string                  `json:"description,omitempty"`
+	DeploymentIDs *[]string                `json:"deployment_ids,omitempty"`
+	Config        *SecretStoreConfigUpdate `json:"config,omitempty"`
+}
+
+// SecretStoreConfigUpdate carries the config fields the backend updates in
+// place. Kind must match the store's kind; the kind and the fields that say
+// where secrets are kept (prefix, region, project, vault URL, address, mount)
+// cannot change. A nil field is left unchanged, and an optional field set to
+// "" is sent as null to clear it.
+type SecretStoreConfigUpdate struct {
+	Kind                    string          `json:"kind"`
+	KmsKeyID                *nullableString `json:"kms_key_id,omitempty"`
+	Namespace               *nullableString `json:"namespace,omitempty"`
+	ManagedIdentityClientID *nullableString `json:"managed_identity_client_id,omitempty"`
+	AuthMethod              *string         `json:"auth_method,omitempty"`
+	AuthMount               *nullableString `json:"auth_mount,omitempty"`
+	Role                    *nullableString `json:"role,omitempty"`
+	RoleID                  *nullableString `json:"role_id,omitempty"`
+	SecretID                *nullableString `json:"secret_id,omitempty"`
 }
 
 // SecretStoreListResponse matches the backend CursorPage shape.
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	secretStoreMigrationsEndpoint = "/v1/secret_store_migrations"
+
+	// secretStoreMigrationPollTimeout bounds a bulk migration, which moves
+	// every credential of a store and so takes far longer than a single
+	// credential's status change.
+	secretStoreMigrationPollTimeout = 30 * time.Minute
+)
+
+// Sync states reported for each secret store holding a credential's values.
+const (
+	SecretStoreSyncStatusPending = "pending"
+	SecretStoreSyncStatusSyncing = "syncing"
+	SecretStoreSyncStatusOk      = "ok"
+	SecretStoreSyncStatusError   = "error"
+)
+
+// SecretStoreSync is the state of a credential's values in one secret store.
+// While a credential moves to another store the API reports both stores: the
+// target until its copy is written, and the source until its copy is removed.
+type SecretStoreSync struct {
+	SecretStoreID string `json:"secret_store_id"`
+	Status        string `json:"status"`
+	StatusDetail  string `json:"status_detail,omitempty"`
+	SyncedAt      string `json:"synced_at,omitempty"`
+}
+
+// secretStoreSyncStatus reduces a credential's per-store sync states to a
+// single waitForStatus status: an error in any store fails the migration,
+// and any store still pending or syncing keeps it running.
+func secretStoreSyncStatus(syncs []SecretStoreSync) (status, statusDetail string) {
+	status = SecretStoreSyncStatusOk
+	for _, s := range syncs {
+		switch s.Status {
+		case SecretStoreSyncStatusError:
+			return SecretStoreSyncStatusError, fmt.Sprintf("secret store %s: %s", s.SecretStoreID, s.StatusDetail)
+		case SecretStoreSyncStatusPending, SecretStoreSyncStatusSyncing:
+			status = SecretStoreSyncStatusSyncing
+		}
+	}
+	return status, ""
+}
+
+// WaitForSecretStoreMigration polls the credential until its values have
+// moved to the secret store it now points at.
+func WaitForSecretStoreMigration(ctx context.Context, c *Client, credentialID string) error {
+	return waitForStatus(ctx, func() (string, string, error) {
+		credential, err := GetAccessCredential(ctx, c, credentialID)
+		if err != nil {
+			return "", "", err
+		}
+		status, detail := secretStoreSyncStatus(credential.SecretStoreSync)
+		return status, detail, nil
+	})
+}
+
+// SecretStoreMigration moves every credential saved in one secret store to
+// another. Status is "pending" or "running" until the migration finishes,
+// then "ok", or "error" if any credential could not be moved.
+type SecretStoreMigration struct {
+	ID                  string   `json:"id,omitempty"`
+	SourceSecretStoreID string   `json:"source_secret_store_id"`
+	TargetSecretStoreID string   `json:"target_secret_store_id"`
+	Status              string   `json:"status,omitempty"`
+	StatusDetail        string   `json:"status_detail,omitempty"`
+	TotalCredentials    int      `json:"total_credentials"`
+	MigratedCredentials int      `json:"migrated_credentials"`
+	FailedCredentialIDs []string `json:"failed_credential_ids,omitempty"`
+	CreatedAt           string   `json:"created_at,omitempty"`
+	CompletedAt         string   `json:"completed_at,omitempty"`
+	CreatedBy           string   `json:"created_by,omitempty"`
+}
+
+type CreateSecretStoreMigrationInput struct {
+	SourceSecretStoreID string `json:"source_secret_store_id"`
+	TargetSecretStoreID string `json:"target_secret_store_id"`
+}
+
+// statusFields reports a failed migration with its progress and the
+// credentials that could not be moved.
+func (m SecretStoreMigration) statusFields() (string, string) {
+	if m.Status != "error" {
+		return m.Status, m.StatusDetail
+	}
+	detail := fmt.Sprintf("migrated %d of %d credentials", m.MigratedCredentials, m.TotalCredentials)
+	if len(m.FailedCredentialIDs) > 0 {
+		detail += "; failed: " + strings.Join(m.FailedCredentialIDs, ", ")
+	}
+	if m.StatusDetail != "" {
+		detail = m.StatusDetail + " (" + detail + ")"
+	}
+	return m.Status, detail
+}
+
+func CreateSecretStoreMigration(ctx context.Context, c *Client, input *CreateSecretStoreMigrationInput) (*SecretStoreMigration, error) {
+	var resp SecretStoreMigration
+	if err := c.doRequest(ctx, http.MethodPost, secretStoreMigrationsEndpoint, input, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func GetSecretStoreMigration(ctx context.Context, c *Client, id string) (*SecretStoreMigration, error) {
+	path := fmt.Sprintf("%s/%s", secretStoreMigrationsEndpoint, id)
+	var resp SecretStoreMigration
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WaitForSecretStoreMigrationComplete polls the migration until every
+// credential has been moved or the migration fails.
+func WaitForSecretStoreMigrationComplete(ctx context.Context, c *Client, id string) error {
+	return waitForStatusWithin(ctx, secretStoreMigrationPollTimeout, func() (string, string, error) {
+		m, err := GetSecretStoreMigration(ctx, c, id)
+		if err != nil {
+			return "", "", err
+		}
+		status, detail := m.statusFields()
+		return status, detail, nil
+	})
+}
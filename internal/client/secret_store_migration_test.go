@@ -0,0 +1,69 @@
+package client
+
+import "testing"
+
+func TestSecretStoreSyncStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		syncs      []SecretStoreSync
+		wantStatus string
+	}{
+		{name: "no stores", wantStatus: "ok"},
+		{
+			name:       "synced",
+			syncs:      []SecretStoreSync{{SecretStoreID: "sst-b", Status: "ok"}},
+			wantStatus: "ok",
+		},
+		{
+			name: "target still syncing",
+			syncs: []SecretStoreSync{
+				{SecretStoreID: "sst-a", Status: "ok"},
+				{SecretStoreID: "sst-b", Status: "syncing"},
+			},
+			wantStatus: "syncing",
+		},
+		{
+			name: "source pending removal",
+			syncs: []SecretStoreSync{
+				{SecretStoreID: "sst-a", Status: "pending"},
+				{SecretStoreID: "sst-b", Status: "ok"},
+			},
+			wantStatus: "syncing",
+		},
+		{
+			name: "error wins over syncing",
+			syncs: []SecretStoreSync{
+				{SecretStoreID: "sst-a", Status: "syncing"},
+				{SecretStoreID: "sst-b", Status: "error", StatusDetail: "access denied"},
+			},
+			wantStatus: "error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status, _ := secretStoreSyncStatus(tc.syncs)
+			if status != tc.wantStatus {
+				t.Errorf("expected %v, got %v", tc.wantStatus, status)
+			}
+		})
+	}
+}
+
+func TestSecretStoreMigrationStatusFields(t *testing.T) {
+	m := SecretStoreMigration{
+		Status:              "error",
+		TotalCredentials:    3,
+		MigratedCredentials: 1,
+		FailedCredentialIDs: []string{"acr-1", "acr-2"},
+	}
+	_, detail := m.statusFields()
+	if want := "migrated 1 of 3 credentials; failed: acr-1, acr-2"; detail != want {
+		t.Errorf("expected %v, got %v", want, detail)
+	}
+
+	m = SecretStoreMigration{Status: "running", StatusDetail: "moving credentials"}
+	if status, detail := m.statusFields(); status != "running" || detail != "moving credentials" {
+		t.Errorf("expected running status to pass through, got %v: %v", status, detail)
+	}
+}
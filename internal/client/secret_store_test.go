@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestUpdateSecretStoreInput_ConfigMarshaling verifies that a config update
+// always names the kind, sends only the changed fields, and clears an optional
+// field with null.
+func TestUpdateSecretStoreInput_ConfigMarshaling(t *testing.T) {
+	tests := []struct {
+		name  string
+		input UpdateSecretStoreInput
+		want  string
+	}{
+		{
+			name:  "no config change",
+			input: UpdateSecretStoreInput{},
+			want:  `{}`,
+		},
+		{
+			name: "set kms key",
+			input: UpdateSecretStoreInput{Config: &SecretStoreConfigUpdate{
+				Kind: SecretStoreKindAWSSM, KmsKeyID: NewNullableString("alias/hush"),
+			}},
+			want: `{"config":{"kind":"aws_sm","kms_key_id":"alias/hush"}}`,
+		},
+		{
+			name: "clear namespace",
+			input: UpdateSecretStoreInput{Config: &SecretStoreConfigUpdate{
+				Kind: SecretStoreKindK8sSecrets, Namespace: NewNullableString(""),
+			}},
+			want: `{"config":{"kind":"k8s_secrets","namespace":null}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatalf("marshal failed: %v", err)
+			}
+			if got := string(b); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -31,7 +31,14 @@ func waitForResourceStatus[T statusResource](ctx context.Context, c *Client, id
 
 func waitForStatus(ctx context.Context, pollFn func() (
 	status, statusDetail string, err error)) error {
-	deadline := time.Now().Add(statusPollTimeout)
+	return waitForStatusWithin(ctx, statusPollTimeout, pollFn)
+}
+
+// waitForStatusWithin is waitForStatus with a timeout of its own, for
+// operations that routinely outlast statusPollTimeout.
+func waitForStatusWithin(ctx context.Context, timeout time.Duration, pollFn func() (
+	status, statusDetail string, err error)) error {
+	deadline := time.Now().Add(timeout)
 
 	for {
 		status, statusDetail, err := pollFn()
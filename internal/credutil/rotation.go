@@ -0,0 +1,127 @@
+package credutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	rotationDesc               = "Rotates the credential's secret on a schedule. A new value of the secret, or a new write-only secret version, is itself a rotation and restarts the interval. Hush replaces secrets it can issue itself; for the others the credential reports the rotation as due until a new secret version is supplied"
+	rotationIntervalDaysDesc   = "The number of days between rotations"
+	rotationRotateNowDesc      = "Any value. Changing it rotates the credential on the next apply, outside the schedule. Ignored on create, where the secret is new anyway"
+	rotationLastRotatedAtDesc  = "When the credential was last rotated, as an RFC3339 timestamp"
+	rotationNextRotationAtDesc = "When the credential is next due for rotation, as an RFC3339 timestamp"
+)
+
+// RotationResourceSchema is the rotation block shared by the static
+// credential resources.
+func RotationResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: rotationDesc,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"interval_days": {
+					Description:  rotationIntervalDaysDesc,
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 3650),
+				},
+				"rotate_now": {
+					Description: rotationRotateNowDesc,
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"last_rotated_at": {
+					Description: rotationLastRotatedAtDesc,
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"next_rotation_at": {
+					Description: rotationNextRotationAtDesc,
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// RotationDataSourceSchema is the computed rotation block of the static
+// credential data sources.
+func RotationDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: rotationDesc,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"interval_days": {
+					Description: rotationIntervalDaysDesc,
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"last_rotated_at": {
+					Description: rotationLastRotatedAtDesc,
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"next_rotation_at": {
+					Description: rotationNextRotationAtDesc,
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// ExpandRotation returns the rotation schedule configured in the rotation
+// block, or nil when there is none.
+func ExpandRotation(list []any) *client.AccessCredentialRotation {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]any)
+	return &client.AccessCredentialRotation{
+		IntervalDays: m["interval_days"].(int),
+	}
+}
+
+// FlattenRotation converts the API's rotation state to the rotation block.
+// rotate_now is never returned by the API, so the value in d is carried over.
+func FlattenRotation(rotation *client.AccessCredentialRotation, d *schema.ResourceData) []any {
+	if rotation == nil {
+		return []any{}
+	}
+	m := map[string]any{
+		"interval_days":    rotation.IntervalDays,
+		"last_rotated_at":  rotation.LastRotatedAt,
+		"next_rotation_at": rotation.NextRotationAt,
+	}
+	if v, ok := d.Get("rotation.0.rotate_now").(string); ok && v != "" {
+		m["rotate_now"] = v
+	}
+	return []any{m}
+}
+
+// RotateIfRequested rotates the credential when rotate_now has changed on an
+// existing credential. Call it from Update after the credential's own update.
+func RotateIfRequested(ctx context.Context, c *client.Client, d *schema.ResourceData) error {
+	if !d.HasChange("rotation.0.rotate_now") {
+		return nil
+	}
+	if v, _ := d.Get("rotation.0.rotate_now").(string); v == "" {
+		return nil
+	}
+	if _, err := client.RotateAccessCredential(ctx, c, d.Id()); err != nil {
+		return fmt.Errorf("failed to rotate access credential '%s': %w", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package credutil
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandRotation(t *testing.T) {
+	cases := []struct {
+		name string
+		list []any
+		want *client.AccessCredentialRotation
+	}{
+		{"no block", []any{}, nil},
+		{"empty block", []any{nil}, nil},
+		{"interval", []any{map[string]any{"interval_days": 30, "rotate_now": "1"}}, &client.AccessCredentialRotation{IntervalDays: 30}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ExpandRotation(tc.list)
+			if (got == nil) != (tc.want == nil) {
+				t.Fatalf("expected %+v, got %+v", tc.want, got)
+			}
+			if got != nil && *got != *tc.want {
+				t.Errorf("expected %+v, got %+v", *tc.want, *got)
+			}
+		})
+	}
+}
+
+// TestFlattenRotation verifies that the API's rotation state is flattened with
+// the configured rotate_now carried over, and that the data source schema,
+// which has no rotate_now, accepts the result.
+func TestFlattenRotation(t *testing.T) {
+	rotation := &client.AccessCredentialRotation{
+		IntervalDays:   30,
+		LastRotatedAt:  "2026-10-01T00:00:00Z",
+		NextRotationAt: "2026-10-31T00:00:00Z",
+	}
+
+	cases := []struct {
+		name          string
+		schema        *schema.Schema
+		raw           map[string]any
+		wantRotateNow string
+	}{
+		{
+			name:          "resource carries rotate_now over",
+			schema:        RotationResourceSchema(),
+			raw:           map[string]any{"rotation": []any{map[string]any{"interval_days": 30, "rotate_now": "2026-10-18"}}},
+			wantRotateNow: "2026-10-18",
+		},
+		{
+			name:   "resource without rotate_now",
+			schema: RotationResourceSchema(),
+			raw:    map[string]any{"rotation": []any{map[string]any{"interval_days": 30}}},
+		},
+		{
+			name:   "data source",
+			schema: RotationDataSourceSchema(),
+			raw:    map[string]any{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"rotation": tc.schema}, tc.raw)
+
+			if err := d.Set("rotation", FlattenRotation(rotation, d)); err != nil {
+				t.Fatalf("unexpected error setting rotation: %v", err)
+			}
+			if got := d.Get("rotation.0.interval_days").(int); got != 30 {
+				t.Errorf("expected interval_days 30, got %d", got)
+			}
+			if got := d.Get("rotation.0.last_rotated_at").(string); got != rotation.LastRotatedAt {
+				t.Errorf("expected last_rotated_at %q, got %q", rotation.LastRotatedAt, got)
+			}
+			if got := d.Get("rotation.0.next_rotation_at").(string); got != rotation.NextRotationAt {
+				t.Errorf("expected next_rotation_at %q, got %q", rotation.NextRotationAt, got)
+			}
+			if tc.schema.Optional {
+				if got := d.Get("rotation.0.rotate_now").(string); got != tc.wantRotateNow {
+					t.Errorf("expected rotate_now %q, got %q", tc.wantRotateNow, got)
+				}
+			}
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"rotation": RotationResourceSchema()}, map[string]any{})
+	if got := FlattenRotation(nil, d); len(got) != 0 {
+		t.Errorf("expected empty list for no rotation, got %v", got)
+	}
+}
@@ -0,0 +1,177 @@
+package credutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+const (
+	secretFingerprintDesc        = "The API's fingerprint of the credential's secret, a salted hash that changes whenever the secret does. The secret itself is never read back"
+	appliedSecretFingerprintDesc = "The secret_fingerprint recorded when Terraform last set the secret. When the API's fingerprint no longer matches it, the secret was changed outside Terraform and the next apply sets the configured secret again. A secret Hush rotated itself is not drift: its fingerprint is adopted on refresh"
+	appliedLastRotatedAtDesc     = "The credential's last rotation time, as an RFC3339 timestamp, when applied_secret_fingerprint was last recorded. A later rotation means Hush replaced the secret, so its new fingerprint is adopted rather than reverted"
+)
+
+// SecretFingerprintSchema is the computed secret_fingerprint attribute shared
+// by the credential resources and data sources. Read sets it from the API.
+func SecretFingerprintSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: secretFingerprintDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+// WithSecretDrift makes a credential resource detect a secret changed outside
+// Terraform, which write-only secrets otherwise hide. It records the API's
+// secret_fingerprint as applied_secret_fingerprint after each create and
+// update, and plans an update when the two no longer match. The resource's
+// Read must set secret_fingerprint, and its Update must send the configured
+// secret again when SecretDrifted reports true.
+//
+// Hush's own rotations, scheduled or on demand, change the fingerprint too.
+// The credential's last rotation time is recorded alongside the fingerprint,
+// and a refresh that finds a later rotation adopts the new fingerprint instead
+// of reporting drift. It is read from the rotation block when the resource has
+// one, and from the API otherwise.
+func WithSecretDrift(r *schema.Resource) *schema.Resource {
+	r.Schema["applied_secret_fingerprint"] = &schema.Schema{
+		Description: appliedSecretFingerprintDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	r.Schema["applied_last_rotated_at"] = &schema.Schema{
+		Description: appliedLastRotatedAtDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	_, hasRotation := r.Schema["rotation"]
+	record := func(ctx context.Context, d *schema.ResourceData, meta any, diags diag.Diagnostics) diag.Diagnostics {
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		rotatedAt, err := lastRotatedAt(ctx, d, meta, hasRotation)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return recordAppliedSecretFingerprint(d, rotatedAt, diags)
+	}
+
+	create, read, update := r.CreateContext, r.ReadContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return record(ctx, d, meta, create(ctx, d, meta))
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return record(ctx, d, meta, update(ctx, d, meta))
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		// An imported credential, or one last applied by a provider that did
+		// not record fingerprints, adopts the secret it has now.
+		applied := d.Get("applied_secret_fingerprint").(string)
+		if applied == "" {
+			return record(ctx, d, meta, diags)
+		}
+		if !secretDrifted(applied, d.Get("secret_fingerprint").(string)) {
+			return diags
+		}
+		rotatedAt, err := lastRotatedAt(ctx, d, meta, hasRotation)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if rotatedSince(d.Get("applied_last_rotated_at").(string), rotatedAt) {
+			return recordAppliedSecretFingerprint(d, rotatedAt, diags)
+		}
+		return diags
+	}
+
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = planSecretDrift
+	} else {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, planSecretDrift)
+	}
+	return r
+}
+
+func recordAppliedSecretFingerprint(d *schema.ResourceData, rotatedAt string, diags diag.Diagnostics) diag.Diagnostics {
+	if err := d.Set("applied_secret_fingerprint", d.Get("secret_fingerprint").(string)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("applied_last_rotated_at", rotatedAt); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// lastRotatedAt returns when the credential was last rotated, or "" if it
+// never was. A resource with a rotation block has just read it; the others
+// ask the API, since hush_access_credential_rotation rotates them as well.
+func lastRotatedAt(ctx context.Context, d *schema.ResourceData, meta any, hasRotation bool) (string, error) {
+	if hasRotation {
+		return d.Get("rotation.0.last_rotated_at").(string), nil
+	}
+	cred, err := client.GetAccessCredential(ctx, meta.(*client.Client), d.Id())
+	if err != nil {
+		return "", fmt.Errorf("failed to read the rotation of access credential '%s': %w", d.Id(), err)
+	}
+	if cred.Rotation == nil {
+		return "", nil
+	}
+	return cred.Rotation.LastRotatedAt, nil
+}
+
+// planSecretDrift plans an update when the secret has drifted. Any update may
+// change the secret, if only by sending it again, so the fingerprints and the
+// recorded rotation are unknown until it is applied.
+func planSecretDrift(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	drifted := secretDrifted(d.Get("applied_secret_fingerprint").(string), d.Get("secret_fingerprint").(string))
+	if !drifted && len(d.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	for _, attr := range []string{"secret_fingerprint", "applied_secret_fingerprint", "applied_last_rotated_at"} {
+		if err := d.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SecretDrifted reports whether the credential's secret was changed outside
+// Terraform since it was last applied. Update sends the configured secret
+// again when it was.
+func SecretDrifted(d *schema.ResourceData) bool {
+	applied, _ := d.GetChange("applied_secret_fingerprint")
+	remote, _ := d.GetChange("secret_fingerprint")
+	return secretDrifted(applied.(string), remote.(string))
+}
+
+// ResendSecret reports whether the secret pair plainAttr and woAttr must be
+// sent again because the secret drifted. Only a configured pair is resent, so
+// a resource with alternative secrets resends the one in use.
+func ResendSecret(d *schema.ResourceData, plainAttr, woAttr string) bool {
+	return SecretDrifted(d) && writeonly.GetString(d, plainAttr, woAttr) != ""
+}
+
+// rotatedSince reports whether the credential was rotated after the rotation
+// recorded with the applied fingerprint. The API only moves last_rotated_at
+// forward, so any new value is a later rotation.
+func rotatedSince(applied, remote string) bool {
+	return remote != "" && remote != applied
+}
+
+// secretDrifted compares the applied and current fingerprints. An API that
+// reports no fingerprint never shows drift.
+func secretDrifted(applied, remote string) bool {
+	return applied != "" && remote != "" && applied != remote
+}
@@ -0,0 +1,170 @@
+package credutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSecretDrifted(t *testing.T) {
+	cases := []struct {
+		name    string
+		applied string
+		remote  string
+		want    bool
+	}{
+		{"unchanged", "fp-1", "fp-1", false},
+		{"changed outside terraform", "fp-1", "fp-2", true},
+		{"nothing recorded", "", "fp-2", false},
+		{"not reported by the api", "fp-1", "", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := secretDrifted(tc.applied, tc.remote); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestWithSecretDrift verifies that the wrapped create and read record the
+// fingerprint the API reports, and that a read leaves a recorded one alone so
+// that drift stays visible to the next plan.
+func TestWithSecretDrift(t *testing.T) {
+	remote := "fp-1"
+	read := func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+		if err := d.Set("secret_fingerprint", remote); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+	r := WithSecretDrift(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"secret_fingerprint": SecretFingerprintSchema(),
+			"rotation":           RotationResourceSchema(),
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId("acr-1")
+			return read(ctx, d, meta)
+		},
+		ReadContext: read,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return read(ctx, d, meta)
+		},
+	})
+	if r.CustomizeDiff == nil {
+		t.Fatal("expected a CustomizeDiff planning drift")
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	if diags := r.CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected create error: %v", diags)
+	}
+	if got := d.Get("applied_secret_fingerprint").(string); got != "fp-1" {
+		t.Fatalf("expected applied fingerprint fp-1 after create, got %q", got)
+	}
+
+	remote = "fp-2"
+	if diags := r.ReadContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected read error: %v", diags)
+	}
+	if got := d.Get("applied_secret_fingerprint").(string); got != "fp-1" {
+		t.Errorf("expected read to keep applied fingerprint fp-1, got %q", got)
+	}
+	if got := d.Get("secret_fingerprint").(string); got != "fp-2" {
+		t.Errorf("expected read to refresh secret_fingerprint to fp-2, got %q", got)
+	}
+
+	imported := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	imported.SetId("acr-1")
+	if diags := r.ReadContext(context.Background(), imported, nil); diags.HasError() {
+		t.Fatalf("unexpected read error: %v", diags)
+	}
+	if got := imported.Get("applied_secret_fingerprint").(string); got != "fp-2" {
+		t.Errorf("expected an imported credential to adopt fp-2, got %q", got)
+	}
+}
+
+func TestRotatedSince(t *testing.T) {
+	cases := []struct {
+		name    string
+		applied string
+		remote  string
+		want    bool
+	}{
+		{"not rotated", "2026-01-01T00:00:00Z", "2026-01-01T00:00:00Z", false},
+		{"rotated again", "2026-01-01T00:00:00Z", "2026-02-01T00:00:00Z", true},
+		{"first rotation", "", "2026-02-01T00:00:00Z", true},
+		{"never rotated", "", "", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := rotatedSince(tc.applied, tc.remote); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestWithSecretDriftAdoptsRotation verifies that a secret Hush rotated after
+// the last apply is adopted on read rather than reported as drift, while a
+// secret changed without a rotation still is.
+func TestWithSecretDriftAdoptsRotation(t *testing.T) {
+	remote, rotatedAt := "fp-1", "2026-01-01T00:00:00Z"
+	read := func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+		if err := d.Set("secret_fingerprint", remote); err != nil {
+			return diag.FromErr(err)
+		}
+		rotation := []any{map[string]any{"interval_days": 30, "last_rotated_at": rotatedAt}}
+		if err := d.Set("rotation", rotation); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+	r := WithSecretDrift(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"secret_fingerprint": SecretFingerprintSchema(),
+			"rotation":           RotationResourceSchema(),
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId("acr-1")
+			return read(ctx, d, meta)
+		},
+		ReadContext: read,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return read(ctx, d, meta)
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	if diags := r.CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected create error: %v", diags)
+	}
+	if got := d.Get("applied_last_rotated_at").(string); got != rotatedAt {
+		t.Fatalf("expected applied rotation %s after create, got %q", rotatedAt, got)
+	}
+
+	remote, rotatedAt = "fp-2", "2026-02-01T00:00:00Z"
+	if diags := r.ReadContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected read error: %v", diags)
+	}
+	if got := d.Get("applied_secret_fingerprint").(string); got != "fp-2" {
+		t.Errorf("expected the rotated fingerprint fp-2 to be adopted, got %q", got)
+	}
+	if got := d.Get("applied_last_rotated_at").(string); got != rotatedAt {
+		t.Errorf("expected applied rotation %s, got %q", rotatedAt, got)
+	}
+	if secretDrifted(d.Get("applied_secret_fingerprint").(string), d.Get("secret_fingerprint").(string)) {
+		t.Error("expected no drift after a rotation")
+	}
+
+	remote = "fp-3"
+	if diags := r.ReadContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected read error: %v", diags)
+	}
+	if got := d.Get("applied_secret_fingerprint").(string); got != "fp-2" {
+		t.Errorf("expected a change without a rotation to keep fp-2, got %q", got)
+	}
+}
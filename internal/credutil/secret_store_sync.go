@@ -0,0 +1,73 @@
+package credutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const secretStoreSyncDesc = "The credential's state in each secret store holding its values. While the credential moves to another secret store both stores are listed until the move completes"
+
+// SecretStoreSyncSchema is the computed secret_store_sync attribute shared by
+// the credential resources and data sources. Read sets it from the API.
+func SecretStoreSyncSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: secretStoreSyncDesc,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_store_id": {
+					Description: "The ID of the secret store",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"status": {
+					Description: "The sync state in this store: `pending`, `syncing`, `ok` or `error`",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"status_detail": {
+					Description: "Why the sync failed, when status is `error`",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"synced_at": {
+					Description: "When the credential's values were last written to this store",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// FlattenSecretStoreSync converts the API's per-store sync states into the
+// secret_store_sync list.
+func FlattenSecretStoreSync(syncs []client.SecretStoreSync) []any {
+	out := make([]any, 0, len(syncs))
+	for _, s := range syncs {
+		out = append(out, map[string]any{
+			"secret_store_id": s.SecretStoreID,
+			"status":          s.Status,
+			"status_detail":   s.StatusDetail,
+			"synced_at":       s.SyncedAt,
+		})
+	}
+	return out
+}
+
+// WaitForSecretStoreMigration waits, after an update that changed
+// secret_store_id, until the credential's values have moved to the new store,
+// so that the apply only succeeds once consumers can read them there.
+func WaitForSecretStoreMigration(ctx context.Context, c *client.Client, d *schema.ResourceData) error {
+	if !d.HasChange("secret_store_id") {
+		return nil
+	}
+	if err := client.WaitForSecretStoreMigration(ctx, c, d.Id()); err != nil {
+		return fmt.Errorf("moving credential to secret store %q: %w", d.Get("secret_store_id").(string), err)
+	}
+	return nil
+}
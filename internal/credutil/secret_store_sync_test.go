@@ -0,0 +1,41 @@
+package credutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+// TestFlattenSecretStoreSync verifies that the per-store sync states are
+// flattened in the API's order and accepted by the computed schema.
+func TestFlattenSecretStoreSync(t *testing.T) {
+	syncs := []client.SecretStoreSync{
+		{SecretStoreID: "sst-old", Status: "pending"},
+		{SecretStoreID: "sst-new", Status: "ok", SyncedAt: "2026-10-18T00:00:00Z"},
+	}
+
+	s := map[string]*schema.Schema{"secret_store_sync": SecretStoreSyncSchema()}
+	d := schema.TestResourceDataRaw(t, s, map[string]any{})
+	if err := d.Set("secret_store_sync", FlattenSecretStoreSync(syncs)); err != nil {
+		t.Fatalf("set failed: %v", err)
+	}
+
+	for i, want := range syncs {
+		prefix := fmt.Sprintf("secret_store_sync.%d", i)
+		if got := d.Get(prefix + ".secret_store_id").(string); got != want.SecretStoreID {
+			t.Errorf("expected %v, got %v", want.SecretStoreID, got)
+		}
+		if got := d.Get(prefix + ".status").(string); got != want.Status {
+			t.Errorf("expected %v, got %v", want.Status, got)
+		}
+	}
+	if got := d.Get("secret_store_sync.1.synced_at").(string); got != syncs[1].SyncedAt {
+		t.Errorf("expected %v, got %v", syncs[1].SyncedAt, got)
+	}
+
+	if got := FlattenSecretStoreSync(nil); len(got) != 0 {
+		t.Errorf("expected no entries, got %v", got)
+	}
+}
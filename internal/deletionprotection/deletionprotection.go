@@ -0,0 +1,36 @@
+// Package deletionprotection holds the deletion_protection attribute shared by
+// resources whose loss breaks others, such as deployments and credentials.
+package deletionprotection
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Schema returns the deletion_protection attribute for a resource described by
+// noun, e.g. "access credential".
+func Schema(noun string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Whether Terraform is prevented from deleting the %s. While true, destroying or replacing it fails; set it to false and apply first. Only Terraform honours this setting, not the Hush API or UI", noun),
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+}
+
+// resourceData is the subset of *schema.ResourceData that Check needs; it
+// keeps the check unit-testable.
+type resourceData interface {
+	Id() string
+	Get(string) any
+}
+
+// Check returns an error when deletion_protection is set. Delete functions
+// call it before calling the API.
+func Check(d resourceData, noun string) error {
+	if protected, _ := d.Get("deletion_protection").(bool); protected {
+		return fmt.Errorf("cannot delete %s %s: deletion_protection is enabled; "+
+			"set deletion_protection = false and apply before destroying or replacing it", noun, d.Id())
+	}
+	return nil
+}
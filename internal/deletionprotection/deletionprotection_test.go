@@ -0,0 +1,29 @@
+package deletionprotection
+
+import "testing"
+
+type fakeData map[string]any
+
+func (f fakeData) Id() string         { return "acr-1" }
+func (f fakeData) Get(key string) any { return f[key] }
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    fakeData
+		wantErr bool
+	}{
+		{name: "unset", data: fakeData{}},
+		{name: "disabled", data: fakeData{"deletion_protection": false}},
+		{name: "enabled", data: fakeData{"deletion_protection": true}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Check(tc.data, "access credential")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
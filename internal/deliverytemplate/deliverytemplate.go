@@ -0,0 +1,114 @@
+// Package deliverytemplate parses and renders the template strings carried by
+// template-type delivery items on access policies.
+//
+// A template is literal text with ${key} placeholders, each naming one key the
+// access credential exposes. The agent substitutes the credential's values when
+// it delivers the item; this package mirrors that so mistakes surface at plan
+// time rather than when a workload fails to start.
+package deliverytemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// keyPattern matches the keys credentials expose, e.g. username or db_name.
+var keyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// segment is one piece of a parsed template: literal text, or a placeholder
+// when key is set.
+type segment struct {
+	text string
+	key  string
+}
+
+// Template is a parsed delivery template.
+type Template struct {
+	segments []segment
+}
+
+// Parse splits tmpl into literal text and ${key} placeholders. It refuses an
+// unterminated placeholder and one whose name is empty or is not a key.
+func Parse(tmpl string) (*Template, error) {
+	t := &Template{}
+	rest := tmpl
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			if rest != "" {
+				t.segments = append(t.segments, segment{text: rest})
+			}
+			return t, nil
+		}
+		if start > 0 {
+			t.segments = append(t.segments, segment{text: rest[:start]})
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated placeholder at offset %d", len(tmpl)-len(rest)+start)
+		}
+		key := rest[start+2 : start+end]
+		if key == "" {
+			return nil, fmt.Errorf("empty placeholder at offset %d", len(tmpl)-len(rest)+start)
+		}
+		if !keyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid placeholder ${%s}: must name a credential key", key)
+		}
+		t.segments = append(t.segments, segment{key: key})
+		rest = rest[start+end+1:]
+	}
+}
+
+// Keys returns the keys the template references, once each, in order of first
+// appearance.
+func (t *Template) Keys() []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, s := range t.segments {
+		if s.key == "" {
+			continue
+		}
+		if _, dup := seen[s.key]; dup {
+			continue
+		}
+		seen[s.key] = struct{}{}
+		keys = append(keys, s.key)
+	}
+	return keys
+}
+
+// Render substitutes values into the template. Every referenced key must have
+// a value; a missing one is reported rather than rendered as empty, since the
+// agent would not deliver such an item either.
+func (t *Template) Render(values map[string]string) (string, error) {
+	var b strings.Builder
+	for _, s := range t.segments {
+		if s.key == "" {
+			b.WriteString(s.text)
+			continue
+		}
+		v, ok := values[s.key]
+		if !ok {
+			return "", fmt.Errorf("no value for key %q", s.key)
+		}
+		b.WriteString(v)
+	}
+	return b.String(), nil
+}
+
+// MissingKeys returns the keys the template references that are not in
+// available, in order of first appearance.
+func (t *Template) MissingKeys(available []string) []string {
+	have := make(map[string]struct{}, len(available))
+	for _, k := range available {
+		have[k] = struct{}{}
+	}
+	var missing []string
+	for _, k := range t.Keys() {
+		if _, ok := have[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,122 @@
+package acc_tests
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hushsecurity/terraform-provider-hush/internal/testutil"
+)
+
+func init() {
+	registerMockSetup(func(ms *testutil.MockServer) {
+		// The data source reads the credential's type before checking it. The
+		// typed create endpoints imply the type rather than taking it in the
+		// body, so the mock records it the way the API does.
+		for _, credentialType := range []string{"postgres", "plaintext"} {
+			ms.OnOperation("access_credentials/"+credentialType, testutil.OpCreate, func(_ testutil.Operation, obj map[string]any) *testutil.HookError {
+				if _, ok := obj["type"]; !ok {
+					obj["type"] = credentialType
+				}
+				return nil
+			})
+		}
+
+		// A check that passes every step, run from the requested deployment or
+		// else the credential's first one.
+		ms.OnAction(http.MethodPost, "/v1/access_credentials/{id}/check", "access_credentials",
+			func(obj, body map[string]any) (map[string]any, *testutil.HookError) {
+				deploymentID, _ := body["deployment_id"].(string)
+				if deploymentID == "" {
+					if ids, ok := obj["deployment_ids"].([]any); ok && len(ids) > 0 {
+						deploymentID, _ = ids[0].(string)
+					}
+				}
+				return map[string]any{
+					"deployment_id":   deploymentID,
+					"reachable":       true,
+					"auth_ok":         true,
+					"can_create_user": true,
+					"tls_verified":    false,
+					"latency_ms":      12,
+					"checked_at":      "2026-01-01T00:00:00Z",
+				}, nil
+			})
+	})
+}
+
+func TestAccDataSourceAccessCredentialCheck(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("postgres_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: postgresAccessCredentialStep1() + accessCredentialCheckPostgres,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.hush_access_credential_check.test", "id",
+						"hush_postgres_access_credential.test", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "deployment_id", mockDeploymentID,
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "reachable", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "auth_ok", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "can_create_user", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "tls_verified", "false",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "latency_ms", "12",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "detail", "",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_credential_check.test", "checked_at", "2026-01-01T00:00:00Z",
+					),
+				),
+			},
+		},
+	})
+}
+
+// A plaintext credential has no admin connection to test, so the data source
+// refuses it before calling the API.
+func TestAccDataSourceAccessCredentialCheck_plaintext(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("plaintext_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config:      accessCredentialCheckPlaintext,
+				ExpectError: regexp.MustCompile(`connectivity checks are not supported for "plaintext" credentials`),
+			},
+		},
+	})
+}
+
+const accessCredentialCheckPostgres = `
+data "hush_access_credential_check" "test" {
+  access_credential_id = hush_postgres_access_credential.test.id
+}
+`
+
+const accessCredentialCheckPlaintext = `
+resource "hush_plaintext_access_credential" "test" {
+  name           = "test-check-plaintext-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  secret         = "s3cr3t-value"
+}
+
+data "hush_access_credential_check" "test" {
+  access_credential_id = hush_plaintext_access_credential.test.id
+}
+`
@@ -0,0 +1,100 @@
+package acc_tests
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hushsecurity/terraform-provider-hush/internal/testutil"
+)
+
+func init() {
+	registerMockSetup(func(ms *testutil.MockServer) {
+		// Each rotation of a credential is one second after the last, so every
+		// rotation has its own rotated_at and resource ID. The stored credential
+		// is left alone: it has no rotation schedule for the timestamp to go in.
+		rotations := map[any]int{}
+		ms.OnAction(http.MethodPost, "/v1/access_credentials/{id}/rotate", "access_credentials",
+			func(obj, _ map[string]any) (map[string]any, *testutil.HookError) {
+				rotations[obj["id"]]++
+				rotatedAt := time.Date(2026, 1, 1, 0, 0, rotations[obj["id"]], 0, time.UTC)
+				return map[string]any{
+					"interval_days":   0,
+					"last_rotated_at": rotatedAt.Format(time.RFC3339),
+				}, nil
+			})
+	})
+}
+
+func TestAccResourceAccessCredentialRotation(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("plaintext_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: accessCredentialRotation("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"hush_access_credential_rotation.test", "access_credential_id",
+						"hush_plaintext_access_credential.test", "id",
+					),
+					resource.TestMatchResourceAttr(
+						"hush_access_credential_rotation.test", "id", regexp.MustCompile(`^acr-.+/2026-01-01T00:00:01Z$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_credential_rotation.test", "rotated_at", "2026-01-01T00:00:01Z",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_credential_rotation.test", "next_rotation_at", "",
+					),
+					recordID("hush_access_credential_rotation.test", &id),
+				),
+			},
+			{
+				// Changing triggers replaces the resource, which rotates again.
+				Config: accessCredentialRotation("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_access_credential_rotation.test", "rotated_at", "2026-01-01T00:00:02Z",
+					),
+					checkIDChanged("hush_access_credential_rotation.test", &id),
+				),
+			},
+			{
+				ResourceName:      "hush_access_credential_rotation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Nothing records what caused a rotation.
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				ResourceName:  "hush_access_credential_rotation.test",
+				ImportState:   true,
+				ImportStateId: "acr-mock-1234",
+				ExpectError:   regexp.MustCompile(`expected '<access_credential_id>/<rotated_at>'`),
+			},
+		},
+	})
+}
+
+func accessCredentialRotation(build string) string {
+	return fmt.Sprintf(`
+resource "hush_plaintext_access_credential" "test" {
+  name           = "test-rotation-target-cred"
+  deployment_ids = ["`+mockDeploymentID+`"]
+  secret         = "s3cr3t-value"
+}
+
+resource "hush_access_credential_rotation" "test" {
+  access_credential_id = hush_plaintext_access_credential.test.id
+
+  triggers = {
+    build = %q
+  }
+}
+`, build)
+}
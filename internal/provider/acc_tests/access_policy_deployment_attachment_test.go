@@ -0,0 +1,136 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hushsecurity/terraform-provider-hush/internal/testutil"
+)
+
+// mockRacingDeploymentID is added by a simulated second writer that updates
+// the race policy's deployment list right after the attachment does.
+const mockRacingDeploymentID = "dep-mock-racing"
+
+func init() {
+	registerMockSetup(func(ms *testutil.MockServer) {
+		ms.OnOperation("access_policies", testutil.OpUpdate, func(_ testutil.Operation, obj map[string]any) *testutil.HookError {
+			// The mock does not model the precondition; drop it rather than
+			// store it as a field.
+			_, conditional := obj["expected_deployment_ids"]
+			delete(obj, "expected_deployment_ids")
+			if conditional && obj["name"] == "test-attachment-race-policy" {
+				ids, _ := obj["deployment_ids"].([]any)
+				obj["deployment_ids"] = append(ids, mockRacingDeploymentID)
+			}
+			return nil
+		})
+	})
+}
+
+func TestAccResourceAccessPolicyDeploymentAttachment(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("access_policy", "v1/access_policies"),
+		Steps: []resource.TestStep{
+			{
+				Config: accessPolicyDeploymentAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"hush_access_policy_deployment_attachment.test", "access_policy_id",
+						"hush_access_policy.test", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy_deployment_attachment.test", "deployment_id", mockDeploymentID2,
+					),
+				),
+			},
+			{
+				// The policy now reads back both deployments and ignores the
+				// difference, and the attachment finds its deployment.
+				Config:   accessPolicyDeploymentAttachmentConfig,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "hush_access_policy_deployment_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// A write that does not read back as written is reported, not taken as
+// success: another writer's change may have been lost.
+func TestAccResourceAccessPolicyDeploymentAttachment_concurrentWrite(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("access_policy", "v1/access_policies"),
+		Steps: []resource.TestStep{
+			{
+				Config:      accessPolicyDeploymentAttachmentRaceConfig,
+				ExpectError: regexp.MustCompile(`changed while trying to attach deployment '` + mockDeploymentID2 + `'`),
+			},
+		},
+	})
+}
+
+const accessPolicyDeploymentAttachmentRaceConfig = `
+resource "hush_access_policy" "test" {
+  name                 = "test-attachment-race-policy"
+  enabled              = true
+  access_credential_id = "` + mockAccessCredentialID + `"
+  access_privilege_ids = ["` + mockAccessPrivilegeID + `"]
+  deployment_ids       = ["` + mockDeploymentID + `"]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "default"
+  }
+
+  env_delivery_config {
+    key  = "port"
+    name = "PORT"
+    type = "key"
+  }
+
+  lifecycle {
+    ignore_changes = [deployment_ids]
+  }
+}
+
+resource "hush_access_policy_deployment_attachment" "test" {
+  access_policy_id = hush_access_policy.test.id
+  deployment_id    = "` + mockDeploymentID2 + `"
+}
+`
+
+const accessPolicyDeploymentAttachmentConfig = `
+resource "hush_access_policy" "test" {
+  name                 = "test-attachment-policy"
+  enabled              = true
+  access_credential_id = "` + mockAccessCredentialID + `"
+  access_privilege_ids = ["` + mockAccessPrivilegeID + `"]
+  deployment_ids       = ["` + mockDeploymentID + `"]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "default"
+  }
+
+  env_delivery_config {
+    key  = "port"
+    name = "PORT"
+    type = "key"
+  }
+
+  lifecycle {
+    ignore_changes = [deployment_ids]
+  }
+}
+
+resource "hush_access_policy_deployment_attachment" "test" {
+  access_policy_id = hush_access_policy.test.id
+  deployment_id    = "` + mockDeploymentID2 + `"
+}
+`
@@ -209,10 +209,30 @@ data "hush_access_policy" "test" {
 func TestAccResourceAccessPolicy_withBothDeliveryConfigs(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("access_policy", "v1/access_policies"),
 		Steps: []resource.TestStep{
 			{
-				Config:      accessPolicyBothDeliveryConfigs(),
-				ExpectError: regexp.MustCompile(`"env_delivery_config": only one of`),
+				Config: accessPolicyBothDeliveryConfigs(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_access_policy.test", "id", regexp.MustCompile("^apl-.+$"),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy.test", "env_delivery_config.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy.test", "env_delivery_config.0.name", "PORT",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy.test", "volume_delivery_config.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy.test", "volume_delivery_config.0.mount_point", "/etc/secrets",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_access_policy.test", "volume_delivery_config.0.item.0.path", "db_password",
+					),
+				),
 			},
 		},
 	})
@@ -222,7 +242,7 @@ func accessPolicyBothDeliveryConfigs() string {
 	return `
 resource "hush_access_policy" "test" {
   name                 = "test-policy-both"
-  description          = "should fail with both delivery configs"
+  description          = "policy with env and volume delivery"
   access_credential_id = "` + mockAccessCredentialID + `"
   access_privilege_ids = ["` + mockAccessPrivilegeID + `"]
   deployment_ids       = ["` + mockDeploymentID + `"]
@@ -280,6 +300,41 @@ resource "hush_access_policy" "test" {
 `
 }
 
+func TestAccResourceAccessPolicy_withInvalidTemplate(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      accessPolicyInvalidTemplate(),
+				ExpectError: regexp.MustCompile(`env_delivery_config.0.key: unterminated placeholder`),
+			},
+		},
+	})
+}
+
+func accessPolicyInvalidTemplate() string {
+	return `
+resource "hush_access_policy" "test" {
+  name                 = "test-policy-invalid-template"
+  description          = "should fail with an unterminated placeholder"
+  access_credential_id = "` + mockAccessCredentialID + `"
+  access_privilege_ids = ["` + mockAccessPrivilegeID + `"]
+  deployment_ids       = ["` + mockDeploymentID + `"]
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "default"
+  }
+
+  env_delivery_config {
+    key  = "postgresql://$${username@host:5432/db"
+    name = "DATABASE_URL"
+    type = "template"
+  }
+}
+`
+}
+
 func TestAccResourceAccessPolicy_withVolumeDelivery(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -1273,3 +1328,114 @@ resource "hush_access_policy" "test" {
 		},
 	})
 }
+
+func TestAccDataSourceAccessPolicyMatch(t *testing.T) {
+	// Not parallel: policies created by other tests in the same deployment and
+	// namespace would match too.
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("access_policy", "v1/access_policies"),
+		Steps: []resource.TestStep{
+			{
+				Config: accessPolicyEnvDeliveryStep1() + accessPolicyMatchDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hush_access_policy_match.matching", "policies.#", "1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.hush_access_policy_match.matching", "policies.0.id",
+						"hush_access_policy.test", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_policy_match.matching", "policies.0.delivery_item.0.delivery_type", "env",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_policy_match.matching", "policies.0.delivery_item.0.target", "PORT",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_access_policy_match.other_namespace", "policies.#", "0",
+					),
+				),
+			},
+		},
+	})
+}
+
+const accessPolicyMatchDataSource = `
+data "hush_access_policy_match" "matching" {
+  deployment_id = "` + mockDeploymentID + `"
+  namespace     = "default"
+
+  depends_on = [hush_access_policy.test]
+}
+
+data "hush_access_policy_match" "other_namespace" {
+  deployment_id = "` + mockDeploymentID + `"
+  namespace     = "kube-system"
+
+  depends_on = [hush_access_policy.test]
+}
+`
+
+func TestAccResourceAccessPolicy_withAccessWindow(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("access_policy", "v1/access_policies"),
+		Steps: []resource.TestStep{
+			{
+				Config: accessPolicyAccessWindow("2020-01-01T00:00:00Z", "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_access_policy.test", "not_before", "2020-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("hush_access_policy.test", "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("hush_access_policy.test", "schedule.0.cron", "* * * * *"),
+					resource.TestCheckResourceAttr("hush_access_policy.test", "schedule.0.timezone", "Europe/London"),
+					resource.TestCheckResourceAttr("hush_access_policy.test", "effective_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Negative test: an expiry already past would create a policy that is never
+// in force, and is refused at plan time.
+func TestAccResourceAccessPolicy_withExpiredAccessWindow(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      accessPolicyAccessWindow("2020-01-01T00:00:00Z", "2020-02-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`is in the past`),
+			},
+		},
+	})
+}
+
+func accessPolicyAccessWindow(notBefore, expiresAt string) string {
+	return `
+resource "hush_access_policy" "test" {
+  name                 = "test-window-policy"
+  enabled              = true
+  access_credential_id = "` + mockAccessCredentialID + `"
+  access_privilege_ids = ["` + mockAccessPrivilegeID + `"]
+  deployment_ids       = ["` + mockDeploymentID + `"]
+  not_before           = "` + notBefore + `"
+  expires_at           = "` + expiresAt + `"
+
+  schedule {
+    cron     = "* * * * *"
+    timezone = "Europe/London"
+  }
+
+  attestation_criteria {
+    type  = "k8s:ns"
+    value = "default"
+  }
+
+  env_delivery_config {
+    key  = "port"
+    name = "PORT"
+    type = "key"
+  }
+}
+`
+}
@@ -0,0 +1,148 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	mockAnthropicAdminAPIKey    = "sk-ant-REDACTED"
+	mockAnthropicOrganizationID = "7c1f3d2a-5b6e-4f80-9a1b-2c3d4e5f6a7b"
+	mockAnthropicWorkspaceID    = "wrkspc_mock_anthropic"
+)
+
+func TestAccResourceAnthropicAccessCredential(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("anthropic_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: anthropicAccessCredentialStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_anthropic_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "name", "test-anthropic-cred",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "organization_id", mockAnthropicOrganizationID,
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "workspace_id", mockAnthropicWorkspaceID,
+					),
+					checkSecretStoreID("hush_anthropic_access_credential.test"),
+				),
+			},
+			{
+				// Dropping workspace_id falls back to the default workspace.
+				Config: anthropicAccessCredentialStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "name", "test-anthropic-cred-updated",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "workspace_id", "",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAnthropicAccessCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("anthropic_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: anthropicAccessCredentialStep1() + anthropicAccessCredentialDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_anthropic_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_anthropic_access_credential.test", "name", "test-anthropic-cred",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_anthropic_access_credential.test", "workspace_id", mockAnthropicWorkspaceID,
+					),
+				),
+			},
+		},
+	})
+}
+
+// Write-only Admin API key rotation. Bumping admin_api_key_wo_version must
+// trigger Update and converge with no perpetual diff.
+func TestAccResourceAnthropicAccessCredential_WOAdminAPIKeyRotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("anthropic_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: anthropicAccessCredentialWOStep("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_credential.test", "admin_api_key_wo_version", "1",
+					),
+					resource.TestCheckNoResourceAttr(
+						"hush_anthropic_access_credential.test", "admin_api_key_wo",
+					),
+				),
+			},
+			{
+				Config: anthropicAccessCredentialWOStep("2"),
+				Check: resource.TestCheckResourceAttr(
+					"hush_anthropic_access_credential.test", "admin_api_key_wo_version", "2",
+				),
+			},
+		},
+	})
+}
+
+func anthropicAccessCredentialStep1() string {
+	return `
+resource "hush_anthropic_access_credential" "test" {
+  name            = "test-anthropic-cred"
+  description     = "test anthropic credential"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  admin_api_key   = "` + mockAnthropicAdminAPIKey + `"
+  organization_id = "` + mockAnthropicOrganizationID + `"
+  workspace_id    = "` + mockAnthropicWorkspaceID + `"
+}
+`
+}
+
+func anthropicAccessCredentialStep2() string {
+	return `
+resource "hush_anthropic_access_credential" "test" {
+  name            = "test-anthropic-cred-updated"
+  description     = "updated anthropic credential"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  admin_api_key   = "` + mockAnthropicAdminAPIKey + `"
+  organization_id = "` + mockAnthropicOrganizationID + `"
+}
+`
+}
+
+func anthropicAccessCredentialWOStep(version string) string {
+	return `
+resource "hush_anthropic_access_credential" "test" {
+  name                     = "test-anthropic-wo"
+  deployment_ids           = ["` + mockDeploymentID + `"]
+  admin_api_key_wo         = "sk-ant-admin01-rotated-v` + version + `"
+  admin_api_key_wo_version = "` + version + `"
+}
+`
+}
+
+const anthropicAccessCredentialDataSource = `
+data "hush_anthropic_access_credential" "test" {
+  id = hush_anthropic_access_credential.test.id
+}
+`
@@ -0,0 +1,194 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAnthropicAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("anthropic_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: anthropicAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_anthropic_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "name", "test-anthropic-priv",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "permission_type", "Developer",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "workspace_id", "wrkspc_mock_anthropic",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "monthly_spend_limit_usd", "500",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "rate_limits.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "rate_limits.0.requests_per_minute", "50",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "rate_limits.1.model", "claude-sonnet-4-5",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "rate_limits.1.output_tokens_per_minute", "8000",
+					),
+				),
+			},
+			{
+				// Dropping the limits removes them.
+				Config: anthropicAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "name", "test-anthropic-priv-updated",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "monthly_spend_limit_usd", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.test", "rate_limits.#", "0",
+					),
+				),
+			},
+			{
+				Config: anthropicAccessPrivilegeRestrictedStep(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.restricted", "permission_type", "Restricted",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.restricted", "permissions.0.name", "messages",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_anthropic_access_privilege.restricted", "permissions.0.level", "write",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAnthropicAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("anthropic_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: anthropicAccessPrivilegeStep1() + anthropicAccessPrivilegeDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_anthropic_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_anthropic_access_privilege.test", "permission_type", "Developer",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_anthropic_access_privilege.test", "monthly_spend_limit_usd", "500",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: validatePermissions (CustomizeDiff) fails at plan time.
+func TestAccResourceAnthropicAccessPrivilege_PermissionsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      anthropicAccessPrivilegeRestrictedWithoutPermissions(),
+				ExpectError: regexp.MustCompile(`permission_type "Restricted" requires permissions`),
+			},
+			{
+				Config:      anthropicAccessPrivilegePermissionsWithRole(),
+				ExpectError: regexp.MustCompile(`permissions are only allowed with permission_type "Restricted"`),
+			},
+		},
+	})
+}
+
+func anthropicAccessPrivilegeStep1() string {
+	return `
+resource "hush_anthropic_access_privilege" "test" {
+  name                    = "test-anthropic-priv"
+  description             = "test anthropic privilege"
+  permission_type         = "Developer"
+  workspace_id            = "wrkspc_mock_anthropic"
+  monthly_spend_limit_usd = 500
+
+  rate_limits {
+    requests_per_minute = 50
+  }
+
+  rate_limits {
+    model                    = "claude-sonnet-4-5"
+    input_tokens_per_minute  = 40000
+    output_tokens_per_minute = 8000
+  }
+}
+`
+}
+
+func anthropicAccessPrivilegeStep2() string {
+	return `
+resource "hush_anthropic_access_privilege" "test" {
+  name            = "test-anthropic-priv-updated"
+  description     = "updated anthropic privilege"
+  permission_type = "Developer"
+  workspace_id    = "wrkspc_mock_anthropic"
+}
+`
+}
+
+func anthropicAccessPrivilegeRestrictedStep() string {
+	return `
+resource "hush_anthropic_access_privilege" "restricted" {
+  name            = "test-anthropic-restricted"
+  permission_type = "Restricted"
+
+  permissions {
+    name  = "messages"
+    level = "write"
+  }
+}
+`
+}
+
+func anthropicAccessPrivilegeRestrictedWithoutPermissions() string {
+	return `
+resource "hush_anthropic_access_privilege" "test" {
+  name            = "test-anthropic-bad"
+  permission_type = "Restricted"
+}
+`
+}
+
+func anthropicAccessPrivilegePermissionsWithRole() string {
+	return `
+resource "hush_anthropic_access_privilege" "test" {
+  name            = "test-anthropic-bad"
+  permission_type = "User"
+
+  permissions {
+    name  = "models"
+    level = "read"
+  }
+}
+`
+}
+
+const anthropicAccessPrivilegeDataSource = `
+data "hush_anthropic_access_privilege" "test" {
+  id = hush_anthropic_access_privilege.test.id
+}
+`
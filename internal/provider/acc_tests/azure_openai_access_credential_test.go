@@ -0,0 +1,223 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	azureOpenAITenantID       = "1111aaaa-1111-1111-1111-111111111111"
+	azureOpenAISubscriptionID = "2222bbbb-2222-2222-2222-222222222222"
+	azureOpenAIClientID       = "3333cccc-3333-3333-3333-333333333333"
+	azureOpenAIOtherTenantID  = "4444dddd-4444-4444-4444-444444444444"
+)
+
+func TestAccResourceAzureOpenAIAccessCredential(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessCredentialStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_azure_openai_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "tenant_id", azureOpenAITenantID,
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "subscription_id", azureOpenAISubscriptionID,
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "resource_group", "my-openai-rg",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "account_name", "my-openai-account",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "client_id", azureOpenAIClientID,
+					),
+				),
+			},
+			{
+				// Re-pointing the credential at another tenant, with the fresh
+				// client_secret that the rebind requires.
+				Config: azureOpenAIAccessCredentialStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "description", "updated azure openai credential",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "tenant_id", azureOpenAIOtherTenantID,
+					),
+				),
+			},
+			{
+				// Moving to another account needs no new secret.
+				Config: azureOpenAIAccessCredentialStep3(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "resource_group", "other-openai-rg",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_credential.test", "account_name", "other-openai-account",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Omitting both client_id and client_secret must be accepted (the
+// access-manager falls back to its default Azure credentials).
+func TestAccResourceAzureOpenAIAccessCredential_DefaultCredentials(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessCredentialDefaultCredentials(),
+				Check: resource.TestCheckResourceAttr(
+					"hush_azure_openai_access_credential.test", "client_id", "",
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureOpenAIAccessCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessCredentialStep1() + azureOpenAIAccessCredentialDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_azure_openai_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_azure_openai_access_credential.test", "account_name", "my-openai-account",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Write-only secret rotation for client_secret. Bumping
+// client_secret_wo_version must trigger Update and converge with no perpetual
+// diff.
+func TestAccResourceAzureOpenAIAccessCredential_WOClientSecretRotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessCredentialWOStep("1"),
+				Check: resource.TestCheckResourceAttr(
+					"hush_azure_openai_access_credential.test", "client_secret_wo_version", "1",
+				),
+			},
+			{
+				Config: azureOpenAIAccessCredentialWOStep("2"),
+				Check: resource.TestCheckResourceAttr(
+					"hush_azure_openai_access_credential.test", "client_secret_wo_version", "2",
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: validateAppCredentials (CustomizeDiff) fails at plan time.
+func TestAccResourceAzureOpenAIAccessCredential_AppCredentialsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				// client_id without client_secret.
+				Config:      azureOpenAIAccessCredentialClientIDOnly(),
+				ExpectError: regexp.MustCompile(`client_id and client_secret must both be set or both be omitted`),
+			},
+			{
+				Config: azureOpenAIAccessCredentialStep1(),
+			},
+			{
+				// tenant_id changed with the stored secret left in place.
+				Config:      azureOpenAIAccessCredentialTenantIDChanged(),
+				ExpectError: regexp.MustCompile(`changing tenant_id requires a new client_secret`),
+			},
+		},
+	})
+}
+
+func azureOpenAIAccessCredentialConfig(description, tenantID, resourceGroup, accountName, appCredentials string) string {
+	return `
+resource "hush_azure_openai_access_credential" "test" {
+  name            = "test-azure-openai-cred"
+  description     = "` + description + `"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  tenant_id       = "` + tenantID + `"
+  subscription_id = "` + azureOpenAISubscriptionID + `"
+  resource_group  = "` + resourceGroup + `"
+  account_name    = "` + accountName + `"
+` + appCredentials + `}
+`
+}
+
+func azureOpenAIAccessCredentialStep1() string {
+	return azureOpenAIAccessCredentialConfig("test azure openai credential", azureOpenAITenantID, "my-openai-rg", "my-openai-account", `
+  client_id     = "`+azureOpenAIClientID+`"
+  client_secret = "test-client-secret-v1"
+`)
+}
+
+func azureOpenAIAccessCredentialStep2() string {
+	return azureOpenAIAccessCredentialConfig("updated azure openai credential", azureOpenAIOtherTenantID, "my-openai-rg", "my-openai-account", `
+  client_id     = "`+azureOpenAIClientID+`"
+  client_secret = "test-client-secret-v2"
+`)
+}
+
+func azureOpenAIAccessCredentialStep3() string {
+	return azureOpenAIAccessCredentialConfig("updated azure openai credential", azureOpenAIOtherTenantID, "other-openai-rg", "other-openai-account", `
+  client_id     = "`+azureOpenAIClientID+`"
+  client_secret = "test-client-secret-v2"
+`)
+}
+
+func azureOpenAIAccessCredentialDefaultCredentials() string {
+	return azureOpenAIAccessCredentialConfig("test azure openai credential", azureOpenAITenantID, "my-openai-rg", "my-openai-account", "")
+}
+
+func azureOpenAIAccessCredentialWOStep(version string) string {
+	return azureOpenAIAccessCredentialConfig("test azure openai credential", azureOpenAITenantID, "my-openai-rg", "my-openai-account", `
+  client_id                = "`+azureOpenAIClientID+`"
+  client_secret_wo         = "test-client-secret-v`+version+`"
+  client_secret_wo_version = "`+version+`"
+`)
+}
+
+func azureOpenAIAccessCredentialClientIDOnly() string {
+	return azureOpenAIAccessCredentialConfig("test azure openai credential", azureOpenAITenantID, "my-openai-rg", "my-openai-account", `
+  client_id = "`+azureOpenAIClientID+`"
+`)
+}
+
+// Same as step 1 except tenant_id, to isolate the rebind rule.
+func azureOpenAIAccessCredentialTenantIDChanged() string {
+	return azureOpenAIAccessCredentialConfig("test azure openai credential", azureOpenAIOtherTenantID, "my-openai-rg", "my-openai-account", `
+  client_id     = "`+azureOpenAIClientID+`"
+  client_secret = "test-client-secret-v1"
+`)
+}
+
+const azureOpenAIAccessCredentialDataSource = `
+data "hush_azure_openai_access_credential" "test" {
+  id = hush_azure_openai_access_credential.test.id
+}
+`
@@ -0,0 +1,128 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAzureOpenAIAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_azure_openai_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "name", "test-azure-openai-priv",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "deployments.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "deployments.0", "gpt-4o-prod",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "tokens_per_minute", "30000",
+					),
+				),
+			},
+			{
+				// Widening to every deployment and dropping the quota.
+				Config: azureOpenAIAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "deployments.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "deployments.0", "*",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_azure_openai_access_privilege.test", "tokens_per_minute", "0",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureOpenAIAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("azure_openai_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: azureOpenAIAccessPrivilegeStep1() + azureOpenAIAccessPrivilegeDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_azure_openai_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_azure_openai_access_privilege.test", "deployments.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_azure_openai_access_privilege.test", "tokens_per_minute", "30000",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: validateDeployments (CustomizeDiff) fails at plan time.
+func TestAccResourceAzureOpenAIAccessPrivilege_DeploymentsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      azureOpenAIAccessPrivilegeDeployments(`"*", "gpt-4o-prod"`),
+				ExpectError: regexp.MustCompile(`"\*" already covers every deployment`),
+			},
+			{
+				Config:      azureOpenAIAccessPrivilegeDeployments(`"gpt-4o-prod", "gpt-4o-prod"`),
+				ExpectError: regexp.MustCompile(`"gpt-4o-prod" is listed more than once`),
+			},
+		},
+	})
+}
+
+func azureOpenAIAccessPrivilegeStep1() string {
+	return `
+resource "hush_azure_openai_access_privilege" "test" {
+  name              = "test-azure-openai-priv"
+  description       = "test azure openai privilege"
+  deployments       = ["gpt-4o-prod", "text-embedding-3-large"]
+  tokens_per_minute = 30000
+}
+`
+}
+
+func azureOpenAIAccessPrivilegeStep2() string {
+	return `
+resource "hush_azure_openai_access_privilege" "test" {
+  name        = "test-azure-openai-priv"
+  description = "test azure openai privilege"
+  deployments = ["*"]
+}
+`
+}
+
+func azureOpenAIAccessPrivilegeDeployments(deployments string) string {
+	return `
+resource "hush_azure_openai_access_privilege" "test" {
+  name        = "test-azure-openai-bad"
+  deployments = [` + deployments + `]
+}
+`
+}
+
+const azureOpenAIAccessPrivilegeDataSource = `
+data "hush_azure_openai_access_privilege" "test" {
+  id = hush_azure_openai_access_privilege.test.id
+}
+`
@@ -0,0 +1,145 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceCassandraAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("cassandra_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: cassandraAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_cassandra_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_cassandra_access_privilege.test", "grants.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_cassandra_access_privilege.test", "grants.0.keyspace", "orders",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_cassandra_access_privilege.test", "grants.1.table", "line_items",
+					),
+				),
+			},
+			{
+				Config: cassandraAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_cassandra_access_privilege.test", "grants.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_cassandra_access_privilege.test", "grants.0.keyspace", "",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: both branches of validateGrants (CustomizeDiff).
+func TestAccResourceCassandraAccessPrivilege_GrantValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      cassandraAccessPrivilegeTableWithoutKeyspace(),
+				ExpectError: regexp.MustCompile(`grants.0: table requires keyspace`),
+			},
+			{
+				Config:      cassandraAccessPrivilegeCreateOnTable(),
+				ExpectError: regexp.MustCompile(`grants.0: CREATE cannot be granted on a table`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceCassandraAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("cassandra_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: cassandraAccessPrivilegeStep1() + cassandraAccessPrivilegeDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hush_cassandra_access_privilege.test", "grants.0.permissions.0", "SELECT",
+					),
+				),
+			},
+		},
+	})
+}
+
+func cassandraAccessPrivilegeStep1() string {
+	return `
+resource "hush_cassandra_access_privilege" "test" {
+  name = "test-cassandra-priv"
+
+  grants {
+    permissions = ["SELECT"]
+    keyspace    = "orders"
+  }
+
+  grants {
+    permissions = ["MODIFY"]
+    keyspace    = "orders"
+    table       = "line_items"
+  }
+}
+`
+}
+
+func cassandraAccessPrivilegeStep2() string {
+	return `
+resource "hush_cassandra_access_privilege" "test" {
+  name = "test-cassandra-priv"
+
+  grants {
+    permissions = ["SELECT"]
+  }
+}
+`
+}
+
+func cassandraAccessPrivilegeTableWithoutKeyspace() string {
+	return `
+resource "hush_cassandra_access_privilege" "test" {
+  name = "test-cassandra-priv"
+
+  grants {
+    permissions = ["SELECT"]
+    table       = "line_items"
+  }
+}
+`
+}
+
+func cassandraAccessPrivilegeCreateOnTable() string {
+	return `
+resource "hush_cassandra_access_privilege" "test" {
+  name = "test-cassandra-priv"
+
+  grants {
+    permissions = ["CREATE"]
+    keyspace    = "orders"
+    table       = "line_items"
+  }
+}
+`
+}
+
+func cassandraAccessPrivilegeDataSource() string {
+	return `
+data "hush_cassandra_access_privilege" "test" {
+  id = hush_cassandra_access_privilege.test.id
+}
+`
+}
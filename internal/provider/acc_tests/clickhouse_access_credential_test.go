@@ -0,0 +1,233 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceClickHouseAccessCredential(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("clickhouse_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: clickhouseAccessCredentialNativeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_clickhouse_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "engine", "native",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "host", "clickhouse.example.com",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "port", "9440",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "tls", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "cluster", "analytics",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "username", "hush_admin",
+					),
+					checkSecretStoreID("hush_clickhouse_access_credential.test"),
+					recordID("hush_clickhouse_access_credential.test", &id),
+				),
+			},
+			{
+				// Dropping the cluster is an in-place update.
+				Config: clickhouseAccessCredentialNativeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "cluster", "",
+					),
+					checkIDUnchanged("hush_clickhouse_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+// Exercises the ClickHouse Cloud engine with a write-only key secret. Bumping
+// key_secret_wo_version updates the credential in place.
+func TestAccResourceClickHouseAccessCredential_cloud(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("clickhouse_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: clickhouseAccessCredentialCloud("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "engine", "clickhouse_cloud",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "service_id", "6d4f2a3b-1c9e-4b7a-9f2d-0e8c5a7b3d1f",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "key_id", "test-key-id",
+					),
+					resource.TestCheckNoResourceAttr(
+						"hush_clickhouse_access_credential.test", "key_secret_wo",
+					),
+					recordID("hush_clickhouse_access_credential.test", &id),
+				),
+			},
+			{
+				Config: clickhouseAccessCredentialCloud("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_credential.test", "key_secret_wo_version", "2",
+					),
+					checkIDUnchanged("hush_clickhouse_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: every branch of validateEngineFields (CustomizeDiff). Each
+// fails at plan time, before any request reaches the mock.
+func TestAccResourceClickHouseAccessCredential_EngineFieldValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      clickhouseAccessCredentialNativeMissingPassword(),
+				ExpectError: regexp.MustCompile(`engine "native" requires:.*password`),
+			},
+			{
+				Config:      clickhouseAccessCredentialCloudWithHost(),
+				ExpectError: regexp.MustCompile(`engine "clickhouse_cloud" does not allow:.*host`),
+			},
+			{
+				Config:      clickhouseAccessCredentialCloudMissingSecret(),
+				ExpectError: regexp.MustCompile(`engine "clickhouse_cloud" requires:.*key_secret`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceClickHouseAccessCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("clickhouse_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: clickhouseAccessCredentialNativeStep1() + clickhouseAccessCredentialDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hush_clickhouse_access_credential.test", "engine", "native",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_clickhouse_access_credential.test", "host", "clickhouse.example.com",
+					),
+				),
+			},
+		},
+	})
+}
+
+func clickhouseAccessCredentialNativeStep1() string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name            = "test-clickhouse-cred"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  engine          = "native"
+  host            = "clickhouse.example.com"
+  port            = 9440
+  tls             = true
+  cluster         = "analytics"
+  username        = "hush_admin"
+  password        = "TestPassword123!"
+}
+`
+}
+
+func clickhouseAccessCredentialNativeStep2() string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name            = "test-clickhouse-cred"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  engine          = "native"
+  host            = "clickhouse.example.com"
+  port            = 9440
+  tls             = true
+  username        = "hush_admin"
+  password        = "TestPassword123!"
+}
+`
+}
+
+func clickhouseAccessCredentialCloud(version string) string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name                  = "test-clickhouse-cloud"
+  deployment_ids        = ["` + mockDeploymentID + `"]
+  engine                = "clickhouse_cloud"
+  organization_id       = "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e"
+  service_id            = "6d4f2a3b-1c9e-4b7a-9f2d-0e8c5a7b3d1f"
+  key_id                = "test-key-id"
+  key_secret_wo         = "key-secret-v` + version + `"
+  key_secret_wo_version = "` + version + `"
+}
+`
+}
+
+func clickhouseAccessCredentialNativeMissingPassword() string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name           = "test-clickhouse-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "native"
+  host           = "clickhouse.example.com"
+  username       = "hush_admin"
+}
+`
+}
+
+func clickhouseAccessCredentialCloudWithHost() string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name            = "test-clickhouse-cloud"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  engine          = "clickhouse_cloud"
+  organization_id = "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e"
+  service_id      = "6d4f2a3b-1c9e-4b7a-9f2d-0e8c5a7b3d1f"
+  key_id          = "test-key-id"
+  key_secret      = "key-secret"
+  host            = "clickhouse.example.com"
+}
+`
+}
+
+func clickhouseAccessCredentialCloudMissingSecret() string {
+	return `
+resource "hush_clickhouse_access_credential" "test" {
+  name            = "test-clickhouse-cloud"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  engine          = "clickhouse_cloud"
+  organization_id = "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e"
+  service_id      = "6d4f2a3b-1c9e-4b7a-9f2d-0e8c5a7b3d1f"
+  key_id          = "test-key-id"
+}
+`
+}
+
+func clickhouseAccessCredentialDataSource() string {
+	return `
+data "hush_clickhouse_access_credential" "test" {
+  id = hush_clickhouse_access_credential.test.id
+}
+`
+}
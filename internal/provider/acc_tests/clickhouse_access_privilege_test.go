@@ -0,0 +1,114 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceClickHouseAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("clickhouse_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: clickhouseAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_clickhouse_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "grants.0.database", "events",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "grants.0.tables.0", "page_views",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "settings_profile", "analyst",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "row_policies.0.condition", "tenant_id = 42",
+					),
+				),
+			},
+			{
+				// Dropping the settings profile and row policies is an in-place update.
+				Config: clickhouseAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "settings_profile", "",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "row_policies.#", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_clickhouse_access_privilege.test", "grants.0.tables.#", "0",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceClickHouseAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("clickhouse_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: clickhouseAccessPrivilegeStep1() + clickhouseAccessPrivilegeDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hush_clickhouse_access_privilege.test", "settings_profile", "analyst",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_clickhouse_access_privilege.test", "row_policies.0.table", "page_views",
+					),
+				),
+			},
+		},
+	})
+}
+
+func clickhouseAccessPrivilegeStep1() string {
+	return `
+resource "hush_clickhouse_access_privilege" "test" {
+  name             = "test-clickhouse-priv"
+  settings_profile = "analyst"
+
+  grants {
+    privileges = ["SELECT"]
+    database   = "events"
+    tables     = ["page_views"]
+  }
+
+  row_policies {
+    database  = "events"
+    table     = "page_views"
+    condition = "tenant_id = 42"
+  }
+}
+`
+}
+
+func clickhouseAccessPrivilegeStep2() string {
+	return `
+resource "hush_clickhouse_access_privilege" "test" {
+  name = "test-clickhouse-priv"
+
+  grants {
+    privileges = ["SELECT", "SHOW TABLES"]
+    database   = "events"
+  }
+}
+`
+}
+
+func clickhouseAccessPrivilegeDataSource() string {
+	return `
+data "hush_clickhouse_access_privilege" "test" {
+  id = hush_clickhouse_access_privilege.test.id
+}
+`
+}
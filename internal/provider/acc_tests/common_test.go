@@ -176,6 +176,14 @@ func validateResourceDestroyed(resource, resourcePath string) func(s *terraform.
 				_, err = client.GetOpenAIAccessPrivilege(context.Background(), c, resourceId)
 			case "mariadb_access_credential":
 				_, err = client.GetMariaDBAccessCredential(context.Background(), c, resourceId)
+			case "mssql_access_credential":
+				_, err = client.GetMSSQLAccessCredential(context.Background(), c, resourceId)
+			case "mssql_access_privilege":
+				_, err = client.GetMSSQLAccessPrivilege(context.Background(), c, resourceId)
+			case "oracle_access_credential":
+				_, err = client.GetOracleAccessCredential(context.Background(), c, resourceId)
+			case "oracle_access_privilege":
+				_, err = client.GetOracleAccessPrivilege(context.Background(), c, resourceId)
 			case "gemini_access_credential":
 				_, err = client.GetGeminiAccessCredential(context.Background(), c, resourceId)
 			case "grok_access_credential":
@@ -260,6 +268,22 @@ func validateResourceDestroyed(resource, resourcePath string) func(s *terraform.
 				_, err = client.GetKafkaAccessCredential(context.Background(), c, resourceId)
 			case "kafka_access_privilege":
 				_, err = client.GetKafkaAccessPrivilege(context.Background(), c, resourceId)
+			case "clickhouse_access_credential":
+				_, err = client.GetClickHouseAccessCredential(context.Background(), c, resourceId)
+			case "clickhouse_access_privilege":
+				_, err = client.GetClickHouseAccessPrivilege(context.Background(), c, resourceId)
+			case "cassandra_access_credential":
+				_, err = client.GetCassandraAccessCredential(context.Background(), c, resourceId)
+			case "cassandra_access_privilege":
+				_, err = client.GetCassandraAccessPrivilege(context.Background(), c, resourceId)
+			case "anthropic_access_credential":
+				_, err = client.GetAnthropicAccessCredential(context.Background(), c, resourceId)
+			case "anthropic_access_privilege":
+				_, err = client.GetAnthropicAccessPrivilege(context.Background(), c, resourceId)
+			case "azure_openai_access_credential":
+				_, err = client.GetAzureOpenAIAccessCredential(context.Background(), c, resourceId)
+			case "azure_openai_access_privilege":
+				_, err = client.GetAzureOpenAIAccessPrivilege(context.Background(), c, resourceId)
 			default:
 				return fmt.Errorf("unknown resource type: %s", resource)
 			}
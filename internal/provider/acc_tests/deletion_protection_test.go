@@ -0,0 +1,154 @@
+package acc_tests
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+// An access credential outside the test's configuration that names the
+// test's deployment, as one managed in another workspace would.
+const deploymentDependentID = "acr-mock-deployment-dependent"
+
+func TestAccResourcePlaintextAccessCredential_deletionProtection(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("plaintext_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: plaintextAccessCredentialProtected(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "deletion_protection", "true",
+					),
+					recordID("hush_plaintext_access_credential.test", &id),
+				),
+			},
+			{
+				Config:      plaintextAccessCredentialProtected(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is enabled`),
+			},
+			{
+				// Turning protection off is an in-place update, after which the
+				// credential can be destroyed.
+				Config: plaintextAccessCredentialProtected(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "deletion_protection", "false",
+					),
+					checkIDUnchanged("hush_plaintext_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDeployment_deletionProtection(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("deployment", "v1/deployments"),
+		Steps: []resource.TestStep{
+			{
+				Config: deploymentProtected(true),
+				Check: resource.TestCheckResourceAttr(
+					"hush_deployment.test", "deletion_protection", "true",
+				),
+			},
+			{
+				Config:      deploymentProtected(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is enabled`),
+			},
+			{
+				Config: deploymentProtected(false),
+				Check: resource.TestCheckResourceAttr(
+					"hush_deployment.test", "deletion_protection", "false",
+				),
+			},
+		},
+	})
+}
+
+// A deployment that a credential managed elsewhere still names is refused,
+// and can be deleted once that credential is gone.
+func TestAccResourceDeployment_refuseDeleteWithDependents(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("deployment", "v1/deployments"),
+		Steps: []resource.TestStep{
+			{
+				Config: deploymentWithDependents,
+				Check:  seedDeploymentDependent("hush_deployment.test"),
+			},
+			{
+				Config:      deploymentWithDependents,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`cannot delete deployment .* still used by 1 resources(?s:.*)` + deploymentDependentID),
+			},
+			{
+				PreConfig: func() {
+					c := provider.Meta().(*client.Client)
+					if err := client.DeleteAccessCredential(context.Background(), c, deploymentDependentID); err != nil {
+						t.Fatalf("failed to delete %s: %v", deploymentDependentID, err)
+					}
+				},
+				Config: deploymentWithDependents,
+			},
+		},
+	})
+}
+
+// seedDeploymentDependent stores a credential naming the deployment just
+// created.
+func seedDeploymentDependent(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		mockServer.SeedObject("access_credentials", deploymentDependentID, map[string]any{
+			"id":             deploymentDependentID,
+			"name":           "test-deployment-dependent",
+			"type":           "plaintext",
+			"deployment_ids": []any{mockDeploymentID, rs.Primary.ID},
+			"status":         "ok",
+		})
+		return nil
+	}
+}
+
+func plaintextAccessCredentialProtected(protected bool) string {
+	return fmt.Sprintf(`
+resource "hush_plaintext_access_credential" "test" {
+  name                = "test-plaintext-protected"
+  deployment_ids      = ["%s"]
+  secret_store_id     = "sst-mock-store-1"
+  secret              = "s3cr3t-value"
+  deletion_protection = %t
+}
+`, mockDeploymentID, protected)
+}
+
+func deploymentProtected(protected bool) string {
+	return fmt.Sprintf(`
+resource "hush_deployment" "test" {
+  name                = "test-deployment-protected"
+  kind                = "k8s"
+  deletion_protection = %t
+}
+`, protected)
+}
+
+const deploymentWithDependents = `
+resource "hush_deployment" "test" {
+  name = "test-deployment-dependents"
+  kind = "k8s"
+}
+`
@@ -96,6 +96,76 @@ func TestAccResourceKafkaAccessCredential_Aiven(t *testing.T) {
 	})
 }
 
+// Exercises the Confluent Cloud engine branch with a write-only cloud API
+// secret; bumping cloud_api_secret_wo_version must trigger Update.
+func TestAccResourceKafkaAccessCredential_ConfluentCloud(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("kafka_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: kafkaAccessCredentialConfluentCloudStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "engine", "confluent_cloud",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "environment_id", "env-abc123",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "cluster_id", "lkc-xyz789",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "cloud_api_key", "CLOUDKEY123",
+					),
+					resource.TestCheckNoResourceAttr(
+						"hush_kafka_access_credential.test", "cloud_api_secret_wo",
+					),
+				),
+			},
+			{
+				Config: kafkaAccessCredentialConfluentCloudStep2(),
+				Check: resource.TestCheckResourceAttr(
+					"hush_kafka_access_credential.test", "cloud_api_secret_wo_version", "2",
+				),
+			},
+		},
+	})
+}
+
+// Exercises the MSK IAM engine branch, including an in-place role_arn update.
+func TestAccResourceKafkaAccessCredential_MSKIAM(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("kafka_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: kafkaAccessCredentialMSKIAMStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "engine", "msk_iam",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "cluster_arn", "arn:aws:kafka:us-east-1:123456789012:cluster/orders/0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "region", "us-east-1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_credential.test", "role_arn", "arn:aws:iam::123456789012:role/hush-msk",
+					),
+				),
+			},
+			{
+				Config: kafkaAccessCredentialMSKIAMStep2(),
+				Check: resource.TestCheckResourceAttr(
+					"hush_kafka_access_credential.test", "role_arn", "arn:aws:iam::123456789012:role/hush-msk-v2",
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceKafkaAccessCredential(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -193,6 +263,16 @@ func TestAccResourceKafkaAccessCredential_EngineFieldValidation(t *testing.T) {
 				Config:      kafkaAccessCredentialAivenWithNativeField(),
 				ExpectError: regexp.MustCompile(`engine "aiven" does not allow:.*bootstrap_servers`),
 			},
+			{
+				// confluent_cloud engine, cloud_api_secret (a required field) omitted.
+				Config:      kafkaAccessCredentialConfluentCloudMissingRequired(),
+				ExpectError: regexp.MustCompile(`engine "confluent_cloud" requires:.*cloud_api_secret`),
+			},
+			{
+				// msk_iam engine with a native-only field set.
+				Config:      kafkaAccessCredentialMSKIAMWithNativeField(),
+				ExpectError: regexp.MustCompile(`engine "msk_iam" does not allow:.*username`),
+			},
 		},
 	})
 }
@@ -276,6 +356,62 @@ resource "hush_kafka_access_credential" "test" {
 `
 }
 
+func kafkaAccessCredentialConfluentCloudStep1() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name                        = "test-kafka-confluent"
+  deployment_ids              = ["` + mockDeploymentID + `"]
+  engine                      = "confluent_cloud"
+  environment_id              = "env-abc123"
+  cluster_id                  = "lkc-xyz789"
+  cloud_api_key               = "CLOUDKEY123"
+  cloud_api_secret_wo         = "cloud-secret-v1"
+  cloud_api_secret_wo_version = "1"
+}
+`
+}
+
+func kafkaAccessCredentialConfluentCloudStep2() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name                        = "test-kafka-confluent"
+  deployment_ids              = ["` + mockDeploymentID + `"]
+  engine                      = "confluent_cloud"
+  environment_id              = "env-abc123"
+  cluster_id                  = "lkc-xyz789"
+  cloud_api_key               = "CLOUDKEY123"
+  cloud_api_secret_wo         = "cloud-secret-v2"
+  cloud_api_secret_wo_version = "2"
+}
+`
+}
+
+func kafkaAccessCredentialMSKIAMStep1() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name           = "test-kafka-msk"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "msk_iam"
+  cluster_arn    = "arn:aws:kafka:us-east-1:123456789012:cluster/orders/0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-1"
+  region         = "us-east-1"
+  role_arn       = "arn:aws:iam::123456789012:role/hush-msk"
+}
+`
+}
+
+func kafkaAccessCredentialMSKIAMStep2() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name           = "test-kafka-msk"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "msk_iam"
+  cluster_arn    = "arn:aws:kafka:us-east-1:123456789012:cluster/orders/0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-1"
+  region         = "us-east-1"
+  role_arn       = "arn:aws:iam::123456789012:role/hush-msk-v2"
+}
+`
+}
+
 func kafkaAccessCredentialWOPasswordStep1() string {
 	return `
 resource "hush_kafka_access_credential" "test" {
@@ -388,6 +524,33 @@ resource "hush_kafka_access_credential" "test" {
 `
 }
 
+func kafkaAccessCredentialConfluentCloudMissingRequired() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name           = "test-kafka-bad"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "confluent_cloud"
+  environment_id = "env-abc123"
+  cluster_id     = "lkc-xyz789"
+  cloud_api_key  = "CLOUDKEY123"
+}
+`
+}
+
+func kafkaAccessCredentialMSKIAMWithNativeField() string {
+	return `
+resource "hush_kafka_access_credential" "test" {
+  name           = "test-kafka-bad"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "msk_iam"
+  cluster_arn    = "arn:aws:kafka:us-east-1:123456789012:cluster/orders/0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-1"
+  region         = "us-east-1"
+  role_arn       = "arn:aws:iam::123456789012:role/hush-msk"
+  username       = "should-not-be-here"
+}
+`
+}
+
 // Identical to the native step 1 except deployment_ids, to isolate the
 // immutability check.
 func kafkaAccessCredentialDeploymentChanged() string {
@@ -51,6 +51,9 @@ func TestAccResourceKafkaAccessPrivilege(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"hush_kafka_access_privilege.test", "acls.1.pattern_type", "PREFIXED",
 					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_privilege.test", "confluent_authorization", "acls",
+					),
 				),
 			},
 			{
@@ -77,6 +80,32 @@ func TestAccResourceKafkaAccessPrivilege(t *testing.T) {
 	})
 }
 
+// Confluent RBAC mode: ALLOW entries on every host map onto role bindings;
+// DENY entries and host restrictions are rejected at plan time.
+func TestAccResourceKafkaAccessPrivilege_ConfluentRBAC(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("kafka_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config:      kafkaAccessPrivilegeRBACWithDeny(),
+				ExpectError: regexp.MustCompile(`acls\.0: DENY cannot be expressed with confluent_authorization "rbac"`),
+			},
+			{
+				Config: kafkaAccessPrivilegeRBAC(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_privilege.test", "confluent_authorization", "rbac",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kafka_access_privilege.test", "acls.#", "1",
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceKafkaAccessPrivilege(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -143,6 +172,40 @@ resource "hush_kafka_access_privilege" "test" {
 `
 }
 
+func kafkaAccessPrivilegeRBAC() string {
+	return `
+resource "hush_kafka_access_privilege" "test" {
+  name                    = "test-kafka-priv-rbac"
+  confluent_authorization = "rbac"
+
+  acls {
+    resource_type   = "Topic"
+    resource_name   = "orders."
+    pattern_type    = "PREFIXED"
+    operation       = "Read"
+    permission_type = "ALLOW"
+  }
+}
+`
+}
+
+func kafkaAccessPrivilegeRBACWithDeny() string {
+	return `
+resource "hush_kafka_access_privilege" "test" {
+  name                    = "test-kafka-priv-rbac"
+  confluent_authorization = "rbac"
+
+  acls {
+    resource_type   = "Topic"
+    resource_name   = "orders."
+    pattern_type    = "PREFIXED"
+    operation       = "Write"
+    permission_type = "DENY"
+  }
+}
+`
+}
+
 const kafkaAccessPrivilegeDataSource = `
 data "hush_kafka_access_privilege" "test" {
   id = hush_kafka_access_privilege.test.id
@@ -40,6 +40,47 @@ func TestAccResourceKVAccessCredential(t *testing.T) {
 	})
 }
 
+// Items supplied as JSON, then moved to the write-only items_wo. Moving them
+// with the same values is an in-place update that changes no fingerprint, and
+// a new items_wo value is not sent until items_wo_version changes.
+func TestAccResourceKVAccessCredential_itemsJSON(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("kv_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: kvAccessCredentialItemsJSON,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_kv_access_credential.test", "item_fingerprints.%", "2",
+					),
+					resource.TestCheckResourceAttrSet(
+						"hush_kv_access_credential.test", "item_fingerprints.DB_HOST",
+					),
+					recordID("hush_kv_access_credential.test", &id),
+				),
+			},
+			{
+				Config: kvAccessCredentialItemsWO,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"hush_kv_access_credential.test", "items_wo",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_kv_access_credential.test", "item_fingerprints.%", "2",
+					),
+					checkIDUnchanged("hush_kv_access_credential.test", &id),
+				),
+			},
+			{
+				Config:   kvAccessCredentialItemsWOChanged,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDataSourceKVAccessCredential(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -89,3 +130,41 @@ data "hush_kv_access_credential" "test" {
   id = hush_kv_access_credential.test.id
 }
 `
+
+const kvAccessCredentialItemsJSON = `
+resource "hush_kv_access_credential" "test" {
+  name           = "test-kv-cred-json"
+  deployment_ids = ["` + mockDeploymentID + `"]
+
+  items_json = jsonencode({
+    DB_HOST = "db.internal"
+    DB_PORT = "5432"
+  })
+}
+`
+
+const kvAccessCredentialItemsWO = `
+resource "hush_kv_access_credential" "test" {
+  name           = "test-kv-cred-json"
+  deployment_ids = ["` + mockDeploymentID + `"]
+
+  items_wo = jsonencode({
+    DB_HOST = "db.internal"
+    DB_PORT = "5432"
+  })
+  items_wo_version = "1"
+}
+`
+
+const kvAccessCredentialItemsWOChanged = `
+resource "hush_kv_access_credential" "test" {
+  name           = "test-kv-cred-json"
+  deployment_ids = ["` + mockDeploymentID + `"]
+
+  items_wo = jsonencode({
+    DB_HOST = "db-replica.internal"
+    DB_PORT = "5432"
+  })
+  items_wo_version = "1"
+}
+`
@@ -0,0 +1,169 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceMSSQLAccessCredential(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("mssql_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: mssqlAccessCredentialStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_mssql_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "name", "test-mssql-cred",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "db_name", "testdb",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "host", "test-sql.example.com",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "port", "1433",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "instance", "SQLEXPRESS",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "encrypt", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "trust_server_certificate", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "auth_method", "sql",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "username", "sa",
+					),
+					checkSecretStoreID("hush_mssql_access_credential.test"),
+					recordID("hush_mssql_access_credential.test", &id),
+				),
+			},
+			{
+				// Moving to Entra ID authentication on Azure SQL is an in-place
+				// update that clears the named instance.
+				Config: mssqlAccessCredentialStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "host", "test-sql.database.windows.net",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "instance", "",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "encrypt", "strict",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "auth_method", "entra_id",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_credential.test", "tenant_id", "11111111-2222-3333-4444-555555555555",
+					),
+					checkIDUnchanged("hush_mssql_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMSSQLAccessCredential_authMethod(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      mssqlAccessCredentialEntraIDWithoutTenant,
+				ExpectError: regexp.MustCompile(`tenant_id is required when auth_method is "entra_id"`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMSSQLAccessCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("mssql_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: mssqlAccessCredentialStep1() + mssqlAccessCredentialDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_mssql_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_mssql_access_credential.test", "name", "test-mssql-cred",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_mssql_access_credential.test", "instance", "SQLEXPRESS",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_mssql_access_credential.test", "auth_method", "sql",
+					),
+				),
+			},
+		},
+	})
+}
+
+func mssqlAccessCredentialStep1() string {
+	return `
+resource "hush_mssql_access_credential" "test" {
+  name                     = "test-mssql-cred"
+  description              = "test mssql credential"
+  deployment_ids           = ["` + mockDeploymentID + `"]
+  secret_store_id          = "sst-mock-store-1"
+  db_name                  = "testdb"
+  host                     = "test-sql.example.com"
+  instance                 = "SQLEXPRESS"
+  trust_server_certificate = true
+  username                 = "sa"
+  password                 = "testpassword123"
+}
+`
+}
+
+func mssqlAccessCredentialStep2() string {
+	return `
+resource "hush_mssql_access_credential" "test" {
+  name            = "test-mssql-cred"
+  description     = "test mssql credential"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  db_name         = "testdb"
+  host            = "test-sql.database.windows.net"
+  encrypt         = "strict"
+  auth_method     = "entra_id"
+  tenant_id       = "11111111-2222-3333-4444-555555555555"
+  username        = "66666666-7777-8888-9999-000000000000"
+  password        = "client-secret"
+}
+`
+}
+
+const mssqlAccessCredentialEntraIDWithoutTenant = `
+resource "hush_mssql_access_credential" "test" {
+  name           = "test-mssql-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  db_name        = "testdb"
+  host           = "test-sql.database.windows.net"
+  auth_method    = "entra_id"
+  username       = "66666666-7777-8888-9999-000000000000"
+  password       = "client-secret"
+}
+`
+
+const mssqlAccessCredentialDataSource = `
+data "hush_mssql_access_credential" "test" {
+  id = hush_mssql_access_credential.test.id
+}
+`
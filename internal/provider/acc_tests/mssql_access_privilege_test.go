@@ -0,0 +1,122 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceMSSQLAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("mssql_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: mssqlAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_mssql_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "name", "test-mssql-priv",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.0.object_type", "TABLE",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.0.schema_name", "sales",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.0.object_names.0", "orders",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.1.object_type", "PROCEDURE",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.1.privileges.0", "EXECUTE",
+					),
+				),
+			},
+			{
+				Config: mssqlAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "name", "test-mssql-priv-updated",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_mssql_access_privilege.test", "grants.0.all_in_schema", "true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMSSQLAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("mssql_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: mssqlAccessPrivilegeStep1() + mssqlAccessPrivilegeDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_mssql_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_mssql_access_privilege.test", "grants.0.schema_name", "sales",
+					),
+				),
+			},
+		},
+	})
+}
+
+func mssqlAccessPrivilegeStep1() string {
+	return `
+resource "hush_mssql_access_privilege" "test" {
+  name        = "test-mssql-priv"
+  description = "test mssql privilege"
+
+  grants {
+    privileges   = ["SELECT", "INSERT"]
+    object_type  = "TABLE"
+    schema_name  = "sales"
+    object_names = ["orders"]
+  }
+
+  grants {
+    privileges   = ["EXECUTE"]
+    object_type  = "PROCEDURE"
+    schema_name  = "sales"
+    object_names = ["usp_place_order"]
+  }
+}
+`
+}
+
+func mssqlAccessPrivilegeStep2() string {
+	return `
+resource "hush_mssql_access_privilege" "test" {
+  name        = "test-mssql-priv-updated"
+  description = "test mssql privilege"
+
+  grants {
+    privileges    = ["SELECT"]
+    object_type   = "TABLE"
+    schema_name   = "sales"
+    all_in_schema = true
+  }
+}
+`
+}
+
+const mssqlAccessPrivilegeDataSource = `
+data "hush_mssql_access_privilege" "test" {
+  id = hush_mssql_access_privilege.test.id
+}
+`
@@ -0,0 +1,166 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceOracleAccessCredential(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("oracle_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: oracleAccessCredentialStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_oracle_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "name", "test-oracle-cred",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "host", "test-oracle.example.com",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "port", "1521",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "service_name", "FINPDB1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "tls", "false",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "username", "hush_admin",
+					),
+					checkSecretStoreID("hush_oracle_access_credential.test"),
+					recordID("hush_oracle_access_credential.test", &id),
+				),
+			},
+			{
+				// Switching to a SID over TLS is an in-place update.
+				Config: oracleAccessCredentialStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "service_name", "",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "sid", "FIN",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "port", "2484",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_credential.test", "tls", "true",
+					),
+					checkIDUnchanged("hush_oracle_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceOracleAccessCredential_validation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      oracleAccessCredentialServiceNameAndSID,
+				ExpectError: regexp.MustCompile(`only one of .service_name,sid. can be specified`),
+			},
+			{
+				Config:      oracleAccessCredentialCAWithoutTLS,
+				ExpectError: regexp.MustCompile(`ssl_ca requires tls = true`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceOracleAccessCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("oracle_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: oracleAccessCredentialStep1() + oracleAccessCredentialDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_oracle_access_credential.test", "id", regexp.MustCompile(`^acr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_oracle_access_credential.test", "service_name", "FINPDB1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_oracle_access_credential.test", "username", "hush_admin",
+					),
+				),
+			},
+		},
+	})
+}
+
+func oracleAccessCredentialStep1() string {
+	return `
+resource "hush_oracle_access_credential" "test" {
+  name            = "test-oracle-cred"
+  description     = "test oracle credential"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  host            = "test-oracle.example.com"
+  service_name    = "FINPDB1"
+  username        = "hush_admin"
+  password        = "testpassword123"
+}
+`
+}
+
+func oracleAccessCredentialStep2() string {
+	return `
+resource "hush_oracle_access_credential" "test" {
+  name            = "test-oracle-cred"
+  description     = "test oracle credential"
+  deployment_ids  = ["` + mockDeploymentID + `"]
+  secret_store_id = "sst-mock-store-1"
+  host            = "test-oracle.example.com"
+  port            = 2484
+  sid             = "FIN"
+  tls             = true
+  username        = "hush_admin"
+  password        = "testpassword123"
+}
+`
+}
+
+const oracleAccessCredentialServiceNameAndSID = `
+resource "hush_oracle_access_credential" "test" {
+  name           = "test-oracle-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  host           = "test-oracle.example.com"
+  service_name   = "FINPDB1"
+  sid            = "FIN"
+  username       = "hush_admin"
+  password       = "testpassword123"
+}
+`
+
+const oracleAccessCredentialCAWithoutTLS = `
+resource "hush_oracle_access_credential" "test" {
+  name           = "test-oracle-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  host           = "test-oracle.example.com"
+  service_name   = "FINPDB1"
+  ssl_ca         = "-----BEGIN CERTIFICATE-----"
+  username       = "hush_admin"
+  password       = "testpassword123"
+}
+`
+
+const oracleAccessCredentialDataSource = `
+data "hush_oracle_access_credential" "test" {
+  id = hush_oracle_access_credential.test.id
+}
+`
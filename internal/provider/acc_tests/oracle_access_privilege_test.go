@@ -0,0 +1,125 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceOracleAccessPrivilege(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("oracle_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: oracleAccessPrivilegeStep1(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_oracle_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "system_privileges.0", "CREATE SESSION",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "object_grants.0.schema_name", "FINANCE",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "object_grants.0.object_names.0", "LEDGER",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "roles.0", "FINANCE_READER",
+					),
+				),
+			},
+			{
+				// Dropping the roles and object grants keeps the system privileges.
+				Config: oracleAccessPrivilegeStep2(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "name", "test-oracle-priv-updated",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "roles.#", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "object_grants.#", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_oracle_access_privilege.test", "system_privileges.#", "2",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceOracleAccessPrivilege_empty(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "hush_oracle_access_privilege" "test" {
+  name = "test-oracle-priv"
+}
+`,
+				ExpectError: regexp.MustCompile(`one of .object_grants,roles,system_privileges. must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceOracleAccessPrivilege(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("oracle_access_privilege", "v1/access_privileges"),
+		Steps: []resource.TestStep{
+			{
+				Config: oracleAccessPrivilegeStep1() + oracleAccessPrivilegeDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.hush_oracle_access_privilege.test", "id", regexp.MustCompile(`^apr-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"data.hush_oracle_access_privilege.test", "object_grants.0.object_type", "TABLE",
+					),
+				),
+			},
+		},
+	})
+}
+
+func oracleAccessPrivilegeStep1() string {
+	return `
+resource "hush_oracle_access_privilege" "test" {
+  name              = "test-oracle-priv"
+  description       = "test oracle privilege"
+  system_privileges = ["CREATE SESSION"]
+  roles             = ["FINANCE_READER"]
+
+  object_grants {
+    privileges   = ["SELECT", "INSERT"]
+    object_type  = "TABLE"
+    schema_name  = "FINANCE"
+    object_names = ["LEDGER"]
+  }
+}
+`
+}
+
+func oracleAccessPrivilegeStep2() string {
+	return `
+resource "hush_oracle_access_privilege" "test" {
+  name              = "test-oracle-priv-updated"
+  description       = "test oracle privilege"
+  system_privileges = ["CREATE SESSION", "SELECT ANY DICTIONARY"]
+}
+`
+}
+
+const oracleAccessPrivilegeDataSource = `
+data "hush_oracle_access_privilege" "test" {
+  id = hush_oracle_access_privilege.test.id
+}
+`
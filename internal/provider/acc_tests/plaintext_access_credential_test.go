@@ -1,7 +1,9 @@
 package acc_tests
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -23,6 +25,11 @@ func TestAccResourcePlaintextAccessCredential(t *testing.T) {
 						"hush_plaintext_access_credential.test", "name", "test-plaintext-cred",
 					),
 					checkSecretStoreID("hush_plaintext_access_credential.test"),
+					// The fingerprint the API reported is recorded as the applied one.
+					resource.TestCheckResourceAttrPair(
+						"hush_plaintext_access_credential.test", "applied_secret_fingerprint",
+						"hush_plaintext_access_credential.test", "secret_fingerprint",
+					),
 					recordID("hush_plaintext_access_credential.test", &id),
 				),
 			},
@@ -81,3 +88,80 @@ data "hush_plaintext_access_credential" "test" {
   id = hush_plaintext_access_credential.test.id
 }
 `
+
+func TestAccResourcePlaintextAccessCredential_base64(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("plaintext_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: plaintextAccessCredentialBase64,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "secret_encoding", "base64",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "content_type", "application/x-pkcs12",
+					),
+				),
+			},
+			{
+				Config:      strings.Replace(plaintextAccessCredentialBase64, "AAECAwQ=", "not base64!", 1),
+				ExpectError: regexp.MustCompile(`expected "secret_base64" to be a base64 string`),
+			},
+		},
+	})
+}
+
+const plaintextAccessCredentialBase64 = `
+resource "hush_plaintext_access_credential" "test" {
+  name           = "test-plaintext-cred-binary"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  secret_base64  = "AAECAwQ="
+  content_type   = "application/x-pkcs12"
+}
+`
+
+func TestAccResourcePlaintextAccessCredential_withRotation(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("plaintext_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: plaintextAccessCredentialRotation(30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "rotation.0.interval_days", "30",
+					),
+					recordID("hush_plaintext_access_credential.test", &id),
+				),
+			},
+			{
+				// Changing the interval is an in-place update.
+				Config: plaintextAccessCredentialRotation(90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_plaintext_access_credential.test", "rotation.0.interval_days", "90",
+					),
+					checkIDUnchanged("hush_plaintext_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+func plaintextAccessCredentialRotation(intervalDays int) string {
+	return fmt.Sprintf(`
+resource "hush_plaintext_access_credential" "test" {
+  name              = "test-plaintext-rotation-cred"
+  deployment_ids    = ["`+mockDeploymentID+`"]
+  secret_wo         = "s3cr3t-value"
+  secret_wo_version = "1"
+
+  rotation {
+    interval_days = %d
+  }
+}
+`, intervalDays)
+}
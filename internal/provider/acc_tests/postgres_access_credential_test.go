@@ -39,6 +39,9 @@ func TestAccResourcePostgresAccessCredential(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"hush_postgres_access_credential.test", "username", "testuser",
 					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "engine", "native",
+					),
 					checkSecretStoreID("hush_postgres_access_credential.test"),
 				),
 			},
@@ -86,6 +89,117 @@ func TestAccDataSourcePostgresAccessCredential(t *testing.T) {
 	})
 }
 
+// The rds_iam engine with no AWS keys: Hush signs RDS authentication tokens
+// with the access-manager's workload identity, so no secret is configured.
+func TestAccResourcePostgresAccessCredential_rdsIAM(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("postgres_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: postgresAccessCredentialRDSIAM("us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "engine", "rds_iam",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "region", "us-east-1",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "access_key_id", "",
+					),
+					resource.TestCheckNoResourceAttr(
+						"hush_postgres_access_credential.test", "password",
+					),
+					recordID("hush_postgres_access_credential.test", &id),
+				),
+			},
+			{
+				Config: postgresAccessCredentialRDSIAM("us-west-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "region", "us-west-2",
+					),
+					checkIDUnchanged("hush_postgres_access_credential.test", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePostgresAccessCredential_cloudSQLIAM(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("postgres_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: postgresAccessCredentialCloudSQLIAM(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "engine", "cloudsql_iam",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "instance_connection_name", "my-project:us-central1:orders-db",
+					),
+					resource.TestCheckNoResourceAttr(
+						"hush_postgres_access_credential.test", "service_account_key_wo",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePostgresAccessCredential_azureAD(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("postgres_access_credential", "v1/access_credentials"),
+		Steps: []resource.TestStep{
+			{
+				Config: postgresAccessCredentialAzureAD(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "engine", "azure_ad",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "tenant_id", "11111111-2222-3333-4444-555555555555",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_postgres_access_credential.test", "client_id", "66666666-7777-8888-9999-000000000000",
+					),
+				),
+			},
+		},
+	})
+}
+
+// Negative tests: validateEngineFields (CustomizeDiff). Each fails at plan
+// time, before any request reaches the mock.
+func TestAccResourcePostgresAccessCredential_EngineFieldValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      postgresAccessCredentialNativeMissingPassword(),
+				ExpectError: regexp.MustCompile(`engine "native" requires:.*password`),
+			},
+			{
+				Config:      postgresAccessCredentialRDSIAMWithPassword(),
+				ExpectError: regexp.MustCompile(`engine "rds_iam" does not allow:.*password`),
+			},
+			{
+				Config:      postgresAccessCredentialCloudSQLIAMMissingInstance(),
+				ExpectError: regexp.MustCompile(`engine "cloudsql_iam" requires:.*instance_connection_name`),
+			},
+			{
+				Config:      postgresAccessCredentialAzureADHalfPair(),
+				ExpectError: regexp.MustCompile(`client_id and client_secret to both be set or both be omitted`),
+			},
+		},
+	})
+}
+
 func postgresAccessCredentialStep1() string {
 	return `
 resource "hush_postgres_access_credential" "test" {
@@ -120,6 +234,109 @@ resource "hush_postgres_access_credential" "test" {
 `
 }
 
+func postgresAccessCredentialRDSIAM(region string) string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-rds-iam"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "rds_iam"
+  db_name        = "testdb"
+  host           = "orders.cluster-abc123.` + region + `.rds.amazonaws.com"
+  ssl_mode       = "verify-full"
+  username       = "hush_admin"
+  region         = "` + region + `"
+}
+`
+}
+
+func postgresAccessCredentialCloudSQLIAM() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name                           = "test-postgres-cloudsql-iam"
+  deployment_ids                 = ["` + mockDeploymentID + `"]
+  engine                         = "cloudsql_iam"
+  db_name                        = "testdb"
+  host                           = "10.20.0.5"
+  username                       = "hush-admin@my-project.iam"
+  instance_connection_name       = "my-project:us-central1:orders-db"
+  service_account_key_wo         = jsonencode({ type = "service_account", project_id = "my-project" })
+  service_account_key_wo_version = "1"
+}
+`
+}
+
+func postgresAccessCredentialAzureAD() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-azure-ad"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "azure_ad"
+  db_name        = "testdb"
+  host           = "orders.postgres.database.azure.com"
+  ssl_mode       = "require"
+  username       = "hush-admin"
+  tenant_id      = "11111111-2222-3333-4444-555555555555"
+  client_id      = "66666666-7777-8888-9999-000000000000"
+  client_secret  = "test-client-secret"
+}
+`
+}
+
+func postgresAccessCredentialNativeMissingPassword() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-cred"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  db_name        = "testdb"
+  host           = "test-db.example.com"
+  username       = "testuser"
+}
+`
+}
+
+func postgresAccessCredentialRDSIAMWithPassword() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-rds-iam"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "rds_iam"
+  db_name        = "testdb"
+  host           = "orders.cluster-abc123.us-east-1.rds.amazonaws.com"
+  username       = "hush_admin"
+  region         = "us-east-1"
+  password       = "testpassword123"
+}
+`
+}
+
+func postgresAccessCredentialCloudSQLIAMMissingInstance() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-cloudsql-iam"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "cloudsql_iam"
+  db_name        = "testdb"
+  host           = "10.20.0.5"
+  username       = "hush-admin@my-project.iam"
+}
+`
+}
+
+func postgresAccessCredentialAzureADHalfPair() string {
+	return `
+resource "hush_postgres_access_credential" "test" {
+  name           = "test-postgres-azure-ad"
+  deployment_ids = ["` + mockDeploymentID + `"]
+  engine         = "azure_ad"
+  db_name        = "testdb"
+  host           = "orders.postgres.database.azure.com"
+  username       = "hush-admin"
+  tenant_id      = "11111111-2222-3333-4444-555555555555"
+  client_id      = "66666666-7777-8888-9999-000000000000"
+}
+`
+}
+
 const postgresAccessCredentialDataSource = `
 data "hush_postgres_access_credential" "test" {
   id = hush_postgres_access_credential.test.id
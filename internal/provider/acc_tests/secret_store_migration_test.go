@@ -0,0 +1,92 @@
+package acc_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecretStoreMigration(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("secret_store", "v1/secret_stores"),
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreMigrationStep1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"hush_secret_store_migration.test", "source_secret_store_id",
+						"hush_secret_store.source", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"hush_secret_store_migration.test", "target_secret_store_id",
+						"hush_secret_store.target", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store_migration.test", "status", "ok",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store_migration.test", "failed_credential_ids.#", "0",
+					),
+				),
+			},
+			{
+				ResourceName:            "hush_secret_store_migration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func TestAccResourceSecretStoreMigration_sameStore(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      secretStoreMigrationSameStore,
+				ExpectError: regexp.MustCompile(`must be different secret stores`),
+			},
+		},
+	})
+}
+
+const secretStoreMigrationStores = `
+resource "hush_secret_store" "source" {
+  name = "test-migration-source"
+
+  aws_sm {
+    prefix = "hush"
+    region = "eu-west-1"
+  }
+}
+
+resource "hush_secret_store" "target" {
+  name = "test-migration-target"
+
+  aws_ssm {
+    prefix = "hush"
+    region = "eu-west-1"
+  }
+}
+`
+
+const secretStoreMigrationStep1 = secretStoreMigrationStores + `
+resource "hush_secret_store_migration" "test" {
+  source_secret_store_id = hush_secret_store.source.id
+  target_secret_store_id = hush_secret_store.target.id
+
+  triggers = {
+    run = "1"
+  }
+}
+`
+
+const secretStoreMigrationSameStore = secretStoreMigrationStores + `
+resource "hush_secret_store_migration" "same" {
+  source_secret_store_id = hush_secret_store.source.id
+  target_secret_store_id = hush_secret_store.source.id
+}
+`
@@ -184,3 +184,301 @@ resource "hush_secret_store" "ssm" {
   }
 }
 `
+
+func TestAccResourceSecretStoreAzureKV(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("secret_store", "v1/secret_stores"),
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreAzureKVStep1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_secret_store.azure", "id", regexp.MustCompile(`^sst-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store.azure", "azure_kv.0.vault_url", "https://hush-test.vault.azure.net/",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store.azure", "azure_kv.0.tenant_id", "00000000-0000-0000-0000-000000000001",
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store.azure", "azure_kv.0.managed_identity_client_id", "00000000-0000-0000-0000-000000000002",
+					),
+					resource.TestCheckResourceAttr("hush_secret_store.azure", "aws_sm.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "hush_secret_store.azure",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceSecretStoreVault(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("secret_store", "v1/secret_stores"),
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreVaultAppRole,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"hush_secret_store.vault", "id", regexp.MustCompile(`^sst-.+$`),
+					),
+					resource.TestCheckResourceAttr(
+						"hush_secret_store.vault", "vault.0.address", "https://vault.example.com:8200",
+					),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.mount", "secret"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.namespace", "admin/team"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.auth_method", "approle"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.role_id", "role-id-1"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.secret_id", "secret-id-1"),
+					recordID("hush_secret_store.vault", &id),
+				),
+			},
+			{
+				ResourceName:            "hush_secret_store.vault",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vault.0.secret_id"},
+			},
+			{
+				Config: secretStoreVaultKubernetes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.auth_method", "kubernetes"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.auth_mount", "k8s-prod"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.role", "hush-access-manager"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.role_id", ""),
+					checkIDChanged("hush_secret_store.vault", &id),
+				),
+			},
+		},
+	})
+}
+
+// The AppRole secret ID supplied write-only. A new value is only sent when
+// secret_id_wo_version changes, so changing the value alone plans nothing.
+func TestAccResourceSecretStoreVault_secretIDWO(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("secret_store", "v1/secret_stores"),
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreVaultAppRoleWO("secret-id-1", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hush_secret_store.vault", "vault.0.secret_id_wo"),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.secret_id", ""),
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.secret_id_wo_version", "1"),
+					recordID("hush_secret_store.vault", &id),
+				),
+			},
+			{
+				Config:   secretStoreVaultAppRoleWO("secret-id-2", "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: secretStoreVaultAppRoleWO("secret-id-2", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_secret_store.vault", "vault.0.secret_id_wo_version", "2"),
+					checkIDUnchanged("hush_secret_store.vault", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecretStoreVault_authValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      secretStoreVaultKubernetesWithoutRole,
+				ExpectError: regexp.MustCompile(`role is required for the kubernetes auth method`),
+			},
+			{
+				Config:      secretStoreVaultAppRoleWithoutSecretID,
+				ExpectError: regexp.MustCompile(`role_id and secret_id are required for the approle auth method`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSecretStoreVault(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreVaultAppRole + `
+data "hush_secret_store" "vault" {
+  id = hush_secret_store.vault.id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hush_secret_store.vault", "vault.0.auth_method", "approle"),
+					resource.TestCheckResourceAttr("data.hush_secret_store.vault", "vault.0.role_id", "role-id-1"),
+					resource.TestCheckNoResourceAttr("data.hush_secret_store.vault", "vault.0.secret_id"),
+				),
+			},
+		},
+	})
+}
+
+const secretStoreAzureKVStep1 = `
+resource "hush_secret_store" "azure" {
+  name = "test-secret-store-azure"
+
+  azure_kv {
+    prefix                     = "hush"
+    vault_url                  = "https://hush-test.vault.azure.net/"
+    tenant_id                  = "00000000-0000-0000-0000-000000000001"
+    managed_identity_client_id = "00000000-0000-0000-0000-000000000002"
+  }
+}
+`
+
+const secretStoreVaultAppRole = `
+resource "hush_secret_store" "vault" {
+  name = "test-secret-store-vault"
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    namespace   = "admin/team"
+    auth_method = "approle"
+    role_id     = "role-id-1"
+    secret_id   = "secret-id-1"
+  }
+}
+`
+
+func secretStoreVaultAppRoleWO(secretID, version string) string {
+	return `
+resource "hush_secret_store" "vault" {
+  name = "test-secret-store-vault-wo"
+
+  vault {
+    prefix               = "hush"
+    address              = "https://vault.example.com:8200"
+    mount                = "secret"
+    auth_method          = "approle"
+    role_id              = "role-id-1"
+    secret_id_wo         = "` + secretID + `"
+    secret_id_wo_version = "` + version + `"
+  }
+}
+`
+}
+
+const secretStoreVaultKubernetes = `
+resource "hush_secret_store" "vault" {
+  name = "test-secret-store-vault"
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    namespace   = "admin/team"
+    auth_method = "kubernetes"
+    auth_mount  = "k8s-prod"
+    role        = "hush-access-manager"
+  }
+}
+`
+
+const secretStoreVaultKubernetesWithoutRole = `
+resource "hush_secret_store" "vault_invalid" {
+  name = "test-secret-store-vault-invalid"
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    auth_method = "kubernetes"
+  }
+}
+`
+
+const secretStoreVaultAppRoleWithoutSecretID = `
+resource "hush_secret_store" "vault_invalid" {
+  name = "test-secret-store-vault-invalid"
+
+  vault {
+    prefix      = "hush"
+    address     = "https://vault.example.com:8200"
+    mount       = "secret"
+    auth_method = "approle"
+    role_id     = "role-id-1"
+  }
+}
+`
+
+// TestAccResourceSecretStoreConfigUpdate verifies that a KMS key change is an
+// in-place update, a region change moves the store to a new one, and a kind
+// change replaces it.
+func TestAccResourceSecretStoreConfigUpdate(t *testing.T) {
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      validateResourceDestroyed("secret_store", "v1/secret_stores"),
+		Steps: []resource.TestStep{
+			{
+				Config: secretStoreConfigUpdate("eu-west-1", ""),
+				Check:  recordID("hush_secret_store.update", &id),
+			},
+			{
+				Config: secretStoreConfigUpdate("eu-west-1", "alias/hush"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_secret_store.update", "aws_sm.0.kms_key_id", "alias/hush"),
+					checkIDUnchanged("hush_secret_store.update", &id),
+				),
+			},
+			{
+				Config: secretStoreConfigUpdate("us-east-1", "alias/hush"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_secret_store.update", "aws_sm.0.region", "us-east-1"),
+					checkIDChanged("hush_secret_store.update", &id),
+					recordID("hush_secret_store.update", &id),
+				),
+			},
+			{
+				Config: secretStoreConfigUpdateK8s,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hush_secret_store.update", "k8s_secrets.0.namespace", "hush-secrets"),
+					resource.TestCheckResourceAttr("hush_secret_store.update", "aws_sm.#", "0"),
+					checkIDChanged("hush_secret_store.update", &id),
+				),
+			},
+		},
+	})
+}
+
+func secretStoreConfigUpdate(region, kmsKeyID string) string {
+	return `
+resource "hush_secret_store" "update" {
+  name = "test-secret-store-update"
+
+  aws_sm {
+    prefix     = "hush"
+    region     = "` + region + `"
+    kms_key_id = "` + kmsKeyID + `"
+  }
+}
+`
+}
+
+const secretStoreConfigUpdateK8s = `
+resource "hush_secret_store" "update" {
+  name = "test-secret-store-update"
+
+  k8s_secrets {
+    prefix    = "hush"
+    namespace = "hush-secrets"
+  }
+}
+`
@@ -0,0 +1,159 @@
+package access_credential_check
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	idDesc                 = "The ID of the access credential checked"
+	accessCredentialIDDesc = "The ID of the access credential to check"
+	deploymentIDDesc       = "The deployment whose access bridge runs the check. Defaults to the credential's deployment"
+	reachableDesc          = "Whether the target accepted a network connection from the access bridge"
+	authOKDesc             = "Whether the credential's admin connection details authenticated"
+	canCreateUserDesc      = "Whether the admin account holds the permissions Hush needs to create short-lived users"
+	tlsVerifiedDesc        = "Whether the target's TLS certificate verified against the configured CA. False when the connection does not use TLS"
+	latencyMSDesc          = "The round-trip time of the connection, in milliseconds"
+	detailDesc             = "A description of the first failed step, empty when every step passed"
+	checkedAtDesc          = "When the check ran, as an RFC3339 timestamp"
+)
+
+// checkableTypes are the dynamic credentials Hush mints short-lived users
+// for, and so the ones whose admin connection it can test.
+var checkableTypes = []client.AccessCredentialType{
+	client.AccessCredentialTypePostgres,
+	client.AccessCredentialTypeMySQL,
+	client.AccessCredentialTypeMariaDB,
+	client.AccessCredentialTypeMSSQL,
+	client.AccessCredentialTypeOracle,
+	client.AccessCredentialTypeMongoDB,
+	client.AccessCredentialTypeRedis,
+	client.AccessCredentialTypeKafka,
+	client.AccessCredentialTypeClickHouse,
+	client.AccessCredentialTypeCassandra,
+	client.AccessCredentialTypeRabbitmq,
+	client.AccessCredentialTypeSnowflake,
+	client.AccessCredentialTypeElasticsearch,
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"access_credential_id": {
+			Description:  accessCredentialIDDesc,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"deployment_id": {
+			Description:  deploymentIDDesc,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+		"reachable": {
+			Description: reachableDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"auth_ok": {
+			Description: authOKDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"can_create_user": {
+			Description: canCreateUserDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"tls_verified": {
+			Description: tlsVerifiedDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"latency_ms": {
+			Description: latencyMSDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"detail": {
+			Description: detailDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"checked_at": {
+			Description: checkedAtDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	c := m.(*client.Client)
+	id := d.Get("access_credential_id").(string)
+
+	credential, err := client.GetAccessCredential(ctx, c, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read access credential '%s': %w", id, err))
+	}
+	if err := checkSupported(credential.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("access credential '%s': %w", id, err))
+	}
+
+	input := &client.CheckAccessCredentialInput{
+		DeploymentID: d.Get("deployment_id").(string),
+	}
+	result, err := client.CheckAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to check access credential '%s': %w", id, err))
+	}
+
+	d.SetId(id)
+
+	fields := map[string]any{
+		"deployment_id":   result.DeploymentID,
+		"reachable":       result.Reachable,
+		"auth_ok":         result.AuthOK,
+		"can_create_user": result.CanCreateUser,
+		"tls_verified":    result.TLSVerified,
+		"latency_ms":      result.LatencyMS,
+		"detail":          result.Detail,
+		"checked_at":      result.CheckedAt,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set %s: %w", field, err))
+		}
+	}
+
+	return nil
+}
+
+// checkSupported refuses a credential Hush has no admin connection to test,
+// naming the types it can, rather than passing on the API's refusal.
+func checkSupported(credentialType client.AccessCredentialType) error {
+	if slices.Contains(checkableTypes, credentialType) {
+		return nil
+	}
+	names := make([]string, len(checkableTypes))
+	for i, t := range checkableTypes {
+		names[i] = string(t)
+	}
+	return fmt.Errorf("connectivity checks are not supported for %q credentials, only for %s",
+		credentialType, strings.Join(names, ", "))
+}
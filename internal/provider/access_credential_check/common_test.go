@@ -0,0 +1,29 @@
+package access_credential_check
+
+import (
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestCheckSupported(t *testing.T) {
+	cases := []struct {
+		credentialType client.AccessCredentialType
+		wantErr        bool
+	}{
+		{client.AccessCredentialTypePostgres, false},
+		{client.AccessCredentialTypeKafka, false},
+		{client.AccessCredentialTypeElasticsearch, false},
+		{client.AccessCredentialTypePlaintext, true},
+		{client.AccessCredentialTypeOpenAI, true},
+		{client.AccessCredentialTypeAWSWIF, true},
+	}
+	for _, tc := range cases {
+		t.Run(string(tc.credentialType), func(t *testing.T) {
+			err := checkSupported(tc.credentialType)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tc.wantErr)
+			}
+		})
+	}
+}
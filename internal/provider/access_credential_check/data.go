@@ -0,0 +1,13 @@
+package access_credential_check
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to test a dynamic access credential's admin connection before relying on it. Hush connects to the target through the deployment's access bridge, authenticates, and checks it may create users, and the result is returned step by step so modules can `precondition` on it. A failed step is reported in the attributes, not as an error. The check runs on every read.",
+		ReadContext: dataSourceRead,
+		Schema:      DataSourceSchema(),
+	}
+}
@@ -0,0 +1,42 @@
+package access_credential_rotation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	accessCredentialIDDesc = "The ID of the access credential to rotate"
+	triggersDesc           = "Arbitrary values that rotate the credential again when any of them changes, such as a CI build number or a timestamp"
+	rotatedAtDesc          = "When this rotation took place, as an RFC3339 timestamp"
+	nextRotationAtDesc     = "When the credential is next due for scheduled rotation, as an RFC3339 timestamp. Empty for a credential without a rotation schedule"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_credential_id": {
+			Description:  accessCredentialIDDesc,
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"triggers": {
+			Description: triggersDesc,
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"rotated_at": {
+			Description: rotatedAtDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"next_rotation_at": {
+			Description: nextRotationAtDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
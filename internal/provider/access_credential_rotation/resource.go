@@ -0,0 +1,107 @@
+package access_credential_rotation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Rotates an access credential on demand, for example from a CI pipeline. Creating the resource rotates the credential once; changing `triggers` rotates it again. Destroying the resource does not undo the rotation. Works with any credential the API can rotate, whether or not it has a `rotation` schedule. Importing `<access_credential_id>/<rotated_at>` records an earlier rotation without rotating again.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		DeleteContext: resourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImport,
+		},
+
+		Schema: ResourceSchema(),
+	}
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	credentialID := d.Get("access_credential_id").(string)
+
+	rotation, err := client.RotateAccessCredential(ctx, c, credentialID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to rotate access credential '%s': %w", credentialID, err))
+	}
+
+	rotatedAt := rotation.LastRotatedAt
+	if rotatedAt == "" {
+		rotatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	d.SetId(rotationID(credentialID, rotatedAt))
+
+	if err := d.Set("rotated_at", rotatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("next_rotation_at", rotation.NextRotationAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceRead only checks that the credential still exists. A rotation is an
+// event rather than an object, so there is nothing else to read back; a later
+// rotation of the same credential does not replace this one.
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	_, err := client.GetAccessCredential(ctx, c, d.Get("access_credential_id").(string))
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceImport takes the ID a create produced. The triggers that caused the
+// rotation are not recorded anywhere, so they are left empty.
+func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	credentialID, rotatedAt, err := parseRotationID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("access_credential_id", credentialID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("rotated_at", rotatedAt); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func rotationID(credentialID, rotatedAt string) string {
+	return credentialID + "/" + rotatedAt
+}
+
+func parseRotationID(id string) (string, string, error) {
+	credentialID, rotatedAt, ok := strings.Cut(id, "/")
+	if !ok || credentialID == "" {
+		return "", "", fmt.Errorf("invalid access credential rotation ID '%s', expected '<access_credential_id>/<rotated_at>'", id)
+	}
+	if _, err := time.Parse(time.RFC3339, rotatedAt); err != nil {
+		return "", "", fmt.Errorf("invalid access credential rotation ID '%s': rotated_at must be an RFC3339 timestamp", id)
+	}
+	return credentialID, rotatedAt, nil
+}
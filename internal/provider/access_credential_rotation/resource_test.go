@@ -0,0 +1,53 @@
+package access_credential_rotation
+
+import "testing"
+
+func TestParseRotationID(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		wantCredentialID string
+		wantRotatedAt    string
+		wantErr          bool
+	}{
+		{
+			name:             "valid",
+			id:               "acr-123/2026-01-01T00:00:00Z",
+			wantCredentialID: "acr-123",
+			wantRotatedAt:    "2026-01-01T00:00:00Z",
+		},
+		{
+			name:             "offset",
+			id:               "acr-123/2026-01-01T02:00:00+02:00",
+			wantCredentialID: "acr-123",
+			wantRotatedAt:    "2026-01-01T02:00:00+02:00",
+		},
+		{name: "credential ID only", id: "acr-123", wantErr: true},
+		{name: "empty credential ID", id: "/2026-01-01T00:00:00Z", wantErr: true},
+		{name: "not a timestamp", id: "acr-123/yesterday", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			credentialID, rotatedAt, err := parseRotationID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q, got none", tc.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if credentialID != tc.wantCredentialID {
+				t.Errorf("expected credential ID %q, got %q", tc.wantCredentialID, credentialID)
+			}
+			if rotatedAt != tc.wantRotatedAt {
+				t.Errorf("expected rotated_at %q, got %q", tc.wantRotatedAt, rotatedAt)
+			}
+			if got := rotationID(credentialID, rotatedAt); got != tc.id {
+				t.Errorf("expected round trip to %q, got %q", tc.id, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package access_policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/accessschedule"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	notBeforeDesc        = "The time the access policy comes into force, as an RFC3339 timestamp such as 2026-11-01T09:00:00Z. Until then it delivers nothing"
+	expiresAtDesc        = "The time the access policy stops being in force, as an RFC3339 timestamp. Must be later than not_before and, when set or changed, in the future"
+	scheduleDesc         = "A recurring schedule limiting the access policy to the minutes a cron expression matches, inside the not_before and expires_at window if set"
+	scheduleCronDesc     = "A five-field cron expression (minute hour day-of-month month day-of-week). The policy is in force during every minute it matches, e.g. `* 9-17 * * 1-5` for 09:00 to 17:59 on weekdays"
+	scheduleTimezoneDesc = "The IANA time zone the cron expression is read in, e.g. Europe/London"
+	effectiveEnabledDesc = "Whether the access policy is in force now: enabled, inside its not_before and expires_at window, and inside its schedule. Reported by the API, or evaluated by the provider at read time when the API does not report it"
+)
+
+func scheduleResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: scheduleDesc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      scheduleCronDesc,
+					ValidateDiagFunc: validation.ToDiagFunc(validateCron),
+				},
+				"timezone": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "UTC",
+					Description:      scheduleTimezoneDesc,
+					ValidateDiagFunc: validation.ToDiagFunc(validateTimezone),
+				},
+			},
+		},
+	}
+}
+
+func scheduleDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: scheduleDesc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: scheduleCronDesc,
+				},
+				"timezone": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: scheduleTimezoneDesc,
+				},
+			},
+		},
+	}
+}
+
+func timestampResourceSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      desc,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: suppressSameInstant,
+	}
+}
+
+func validateCron(v any, k string) ([]string, []error) {
+	if _, err := accessschedule.Parse(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+	return nil, nil
+}
+
+func validateTimezone(v any, k string) ([]string, []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: unknown time zone %q", k, v.(string))}
+	}
+	return nil, nil
+}
+
+// suppressSameInstant hides a difference between two timestamps naming the
+// same instant, e.g. the API normalising +00:00 to Z.
+func suppressSameInstant(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, errOld := time.Parse(time.RFC3339, old)
+	newTime, errNew := time.Parse(time.RFC3339, new)
+	return errOld == nil && errNew == nil && oldTime.Equal(newTime)
+}
+
+// validateAccessWindow refuses an expiry that does not come after not_before
+// and, when checkExpiry is set, one already past, which would create a policy
+// that is never in force. Only a new or changed expiry is checked against the
+// clock: a policy that has since expired must still plan, to report it.
+func validateAccessWindow(notBefore, expiresAt string, checkExpiry bool, now time.Time) error {
+	if expiresAt == "" {
+		return nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return nil
+	}
+	if notBefore != "" {
+		start, err := time.Parse(time.RFC3339, notBefore)
+		if err == nil && !expiry.After(start) {
+			return fmt.Errorf("expires_at (%s) must be later than not_before (%s)", expiresAt, notBefore)
+		}
+	}
+	if checkExpiry && !expiry.After(now) {
+		return fmt.Errorf("expires_at (%s) is in the past; the access policy would never be in force", expiresAt)
+	}
+	return nil
+}
+
+// hasExpired reports whether expiresAt is set and no later than now.
+func hasExpired(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && !expiry.After(now)
+}
+
+// evaluateEffectiveEnabled mirrors the API's effective_enabled for an API that
+// does not report it. A timestamp or schedule that does not parse leaves the
+// policy in force, as it would be had the field not been set.
+func evaluateEffectiveEnabled(policy *client.AccessPolicy, now time.Time) bool {
+	if !policy.Enabled || hasExpired(policy.ExpiresAt, now) {
+		return false
+	}
+	if policy.NotBefore != "" {
+		if start, err := time.Parse(time.RFC3339, policy.NotBefore); err == nil && now.Before(start) {
+			return false
+		}
+	}
+	if policy.Schedule != nil {
+		s, err := accessschedule.Parse(policy.Schedule.Cron)
+		if err != nil {
+			return true
+		}
+		loc, err := time.LoadLocation(policy.Schedule.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		return s.Matches(now.In(loc))
+	}
+	return true
+}
+
+func effectiveEnabled(policy *client.AccessPolicy, now time.Time) bool {
+	if policy.EffectiveEnabled != nil {
+		return *policy.EffectiveEnabled
+	}
+	return evaluateEffectiveEnabled(policy, now)
+}
+
+func expandSchedule(list []any) *client.AccessPolicySchedule {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]any)
+	return &client.AccessPolicySchedule{
+		Cron:     m["cron"].(string),
+		Timezone: m["timezone"].(string),
+	}
+}
+
+func flattenSchedule(schedule *client.AccessPolicySchedule) []any {
+	if schedule == nil {
+		return []any{}
+	}
+	timezone := schedule.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	return []any{map[string]any{
+		"cron":     schedule.Cron,
+		"timezone": timezone,
+	}}
+}
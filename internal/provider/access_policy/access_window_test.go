@@ -0,0 +1,111 @@
+package access_policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestValidateAccessWindow(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		notBefore   string
+		expiresAt   string
+		checkExpiry bool
+		wantErr     bool
+	}{
+		{name: "no window"},
+		{name: "open start", expiresAt: "2026-10-02T00:00:00Z", checkExpiry: true},
+		{name: "open end", notBefore: "2026-09-01T00:00:00Z", checkExpiry: true},
+		{name: "valid window", notBefore: "2026-10-01T00:00:00Z", expiresAt: "2026-10-08T00:00:00Z", checkExpiry: true},
+		{name: "expiry before start", notBefore: "2026-10-08T00:00:00Z", expiresAt: "2026-10-02T00:00:00Z", wantErr: true},
+		{name: "expiry equal to start", notBefore: "2026-10-08T00:00:00Z", expiresAt: "2026-10-08T02:00:00+02:00", wantErr: true},
+		{name: "new expiry in the past", expiresAt: "2026-09-30T00:00:00Z", checkExpiry: true, wantErr: true},
+		{name: "unchanged expiry in the past", expiresAt: "2026-09-30T00:00:00Z"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAccessWindow(tc.notBefore, tc.expiresAt, tc.checkExpiry, now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestEffectiveEnabled(t *testing.T) {
+	// 2026-10-05 is a Monday.
+	now := time.Date(2026, 10, 5, 10, 30, 0, 0, time.UTC)
+	reported := false
+
+	tests := []struct {
+		name   string
+		policy client.AccessPolicy
+		want   bool
+	}{
+		{name: "enabled", policy: client.AccessPolicy{Enabled: true}, want: true},
+		{name: "disabled", policy: client.AccessPolicy{Enabled: false}, want: false},
+		{
+			name:   "inside window",
+			policy: client.AccessPolicy{Enabled: true, NotBefore: "2026-10-01T00:00:00Z", ExpiresAt: "2026-10-08T00:00:00Z"},
+			want:   true,
+		},
+		{
+			name:   "not yet in force",
+			policy: client.AccessPolicy{Enabled: true, NotBefore: "2026-10-06T00:00:00Z"},
+			want:   false,
+		},
+		{
+			name:   "expired",
+			policy: client.AccessPolicy{Enabled: true, ExpiresAt: "2026-10-05T10:30:00Z"},
+			want:   false,
+		},
+		{
+			name:   "inside schedule",
+			policy: client.AccessPolicy{Enabled: true, Schedule: &client.AccessPolicySchedule{Cron: "* 9-17 * * 1-5", Timezone: "UTC"}},
+			want:   true,
+		},
+		{
+			name:   "schedule read in its time zone",
+			policy: client.AccessPolicy{Enabled: true, Schedule: &client.AccessPolicySchedule{Cron: "* 9-17 * * 1-5", Timezone: "America/Los_Angeles"}},
+			want:   false,
+		},
+		{
+			name:   "reported by the API",
+			policy: client.AccessPolicy{Enabled: true, EffectiveEnabled: &reported},
+			want:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := effectiveEnabled(&tc.policy, now); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenSchedule(t *testing.T) {
+	if got := flattenSchedule(nil); len(got) != 0 {
+		t.Errorf("expected empty list for no schedule, got %v", got)
+	}
+
+	got := flattenSchedule(&client.AccessPolicySchedule{Cron: "* 9-17 * * 1-5"})
+	m := got[0].(map[string]any)
+	if m["cron"] != "* 9-17 * * 1-5" {
+		t.Errorf("expected cron %q, got %v", "* 9-17 * * 1-5", m["cron"])
+	}
+	if m["timezone"] != "UTC" {
+		t.Errorf("expected an unreported time zone to read as UTC, got %v", m["timezone"])
+	}
+
+	expanded := expandSchedule(got)
+	if expanded == nil || expanded.Cron != "* 9-17 * * 1-5" || expanded.Timezone != "UTC" {
+		t.Errorf("expected round trip, got %+v", expanded)
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
@@ -28,11 +31,103 @@ const (
 	sdkDeliveryConfigDesc      = "SDK delivery configuration for the access policy"
 	statusDesc                 = "The status of the access policy (syncing, ok, warning, error, disabled)"
 	statusDetailDesc           = "The status detail of the access policy"
+	volumeItemDesc             = "A file holding one credential key or rendered template. The secret of a plaintext credential set as base64 is written as its decoded bytes"
+	volumeRenderAsDesc         = "Renders the whole credential, every key, into a single file instead of one file per key. May be combined with item blocks"
+	volumeRenderFormatDesc     = "The file format the credential is rendered in (env, json, yaml, properties)"
+	fileModeDesc               = "The file permissions as a four-digit octal string, e.g. 0400. Defaults to the agent's default when omitted"
+	fileUIDDesc                = "The numeric user ID owning the file, 0 for root. Defaults to the agent's default when omitted"
+	fileGIDDesc                = "The numeric group ID owning the file, 0 for root. Defaults to the agent's default when omitted"
+	requiredDeploymentsDesc    = "The deployments that must sync for create and update to succeed. When omitted, every deployment in deployment_ids must. Listing a subset lets a failing deployment outside it, such as a broken dev cluster, be reported in deployment_status without failing the apply. Each must be one of deployment_ids. It relies on the API reporting each deployment's status; when it reports none, the policy's overall status is waited on instead and the apply warns"
+	deploymentStatusDesc       = "The sync status of the access policy in each of its deployments. status and status_detail summarise these, so one failing deployment marks the whole policy"
 )
 
+var volumeRenderFormats = []string{
+	string(client.VolumeRenderFormatEnv),
+	string(client.VolumeRenderFormatJSON),
+	string(client.VolumeRenderFormatYAML),
+	string(client.VolumeRenderFormatProperties),
+}
+
+// volumeContentAtLeastOneOf requires a volume to hold something: files per key,
+// the whole credential in one file, or both.
+var volumeContentAtLeastOneOf = []string{"volume_delivery_config.0.item", "volume_delivery_config.0.render_as"}
+
+var fileModeRegex = regexp.MustCompile(`^0[0-7]{3}$`)
+
+func fileModeResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(fileModeRegex, "mode must be a four-digit octal string such as 0400"),
+		Description:  fileModeDesc,
+	}
+}
+
+func fileOwnerResourceSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  description,
+	}
+}
+
+func fileModeDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fileModeDesc,
+	}
+}
+
+func fileOwnerDataSourceSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+// deploymentStatusSchema describes one deployment_status entry. It is computed
+// on the resource and the data source alike.
+func deploymentStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: deploymentStatusDesc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"deployment_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the deployment",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the access policy in the deployment (syncing, ok, warning, error, disabled)",
+				},
+				"detail": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status detail of the access policy in the deployment",
+				},
+				"last_synced_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "When the access policy last synced to the deployment",
+				},
+			},
+		},
+	}
+}
+
 var sdkNameRegex = regexp.MustCompile(`^[a-zA-Z0-9/_+=.@-]+$`)
 
-var deliveryConfigExactlyOneOf = []string{"env_delivery_config", "volume_delivery_config", "aws_wif_delivery_config", "gcp_wif_delivery_config", "azure_wif_delivery_config", "sdk_delivery_config"}
+// deliveryConfigAtLeastOneOf names every delivery block. A policy needs at
+// least one of them and may combine several, each stored as its own entry in
+// the API's delivery_configs list.
+var deliveryConfigAtLeastOneOf = []string{"env_delivery_config", "volume_delivery_config", "aws_wif_delivery_config", "gcp_wif_delivery_config", "azure_wif_delivery_config", "sdk_delivery_config"}
 
 func AccessPolicyResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -59,6 +154,14 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Default:     true,
 			Description: enabledDesc,
 		},
+		"not_before": timestampResourceSchema(notBeforeDesc),
+		"expires_at": timestampResourceSchema(expiresAtDesc),
+		"schedule":   scheduleResourceSchema(),
+		"effective_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: effectiveEnabledDesc,
+		},
 		"access_credential_id": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -115,7 +218,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Type:         schema.TypeList,
 			Optional:     true,
 			Description:  envDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"key": {
@@ -143,7 +246,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			MaxItems:     1,
 			Description:  volumeDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"mount_point": {
@@ -152,9 +255,11 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 						Description: "The absolute path where the volume will be mounted",
 					},
 					"item": {
-						Type:     schema.TypeList,
-						Required: true,
-						MinItems: 1,
+						Type:         schema.TypeList,
+						Optional:     true,
+						MinItems:     1,
+						Description:  volumeItemDesc,
+						AtLeastOneOf: volumeContentAtLeastOneOf,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"path": {
@@ -174,6 +279,34 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 									ValidateFunc: validation.StringInSlice([]string{string(client.DeliveryMappingTypeKey), string(client.DeliveryMappingTypeTemplate)}, false),
 									Description:  "The type of delivery item mapping (key or template)",
 								},
+								"mode": fileModeResourceSchema(),
+								"uid":  fileOwnerResourceSchema(fileUIDDesc),
+								"gid":  fileOwnerResourceSchema(fileGIDDesc),
+							},
+						},
+					},
+					"render_as": {
+						Type:         schema.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						Description:  volumeRenderAsDesc,
+						AtLeastOneOf: volumeContentAtLeastOneOf,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The relative file path within the mount point",
+								},
+								"format": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(volumeRenderFormats, false),
+									Description:  volumeRenderFormatDesc,
+								},
+								"mode": fileModeResourceSchema(),
+								"uid":  fileOwnerResourceSchema(fileUIDDesc),
+								"gid":  fileOwnerResourceSchema(fileGIDDesc),
 							},
 						},
 					},
@@ -185,7 +318,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			MaxItems:     1,
 			Description:  awsWifDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"role_arn": {
@@ -203,7 +336,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			MaxItems:     1,
 			Description:  gcpWifDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"subject_kind": wifSubjectKindResourceSchema(),
@@ -228,7 +361,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			MaxItems:     1,
 			Description:  azureWifDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"tenant_id": {
@@ -253,7 +386,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			MaxItems:     1,
 			Description:  sdkDeliveryConfigDesc,
-			ExactlyOneOf: deliveryConfigExactlyOneOf,
+			AtLeastOneOf: deliveryConfigAtLeastOneOf,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"secret_name": {
@@ -299,6 +432,16 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"required_deployments": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: requiredDeploymentsDesc,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+			},
+		},
+		"deployment_status": deploymentStatusSchema(),
 		"status": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -309,6 +452,7 @@ func AccessPolicyResourceSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: statusDetailDesc,
 		},
+		"deletion_protection": deletionprotection.Schema("access policy"),
 	}
 }
 
@@ -334,6 +478,22 @@ func AccessPolicyDataSourceSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: enabledDesc,
 		},
+		"not_before": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: notBeforeDesc,
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: expiresAtDesc,
+		},
+		"schedule": scheduleDataSourceSchema(),
+		"effective_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: effectiveEnabledDesc,
+		},
 		"access_credential_id": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -415,8 +575,9 @@ func AccessPolicyDataSourceSchema() map[string]*schema.Schema {
 						Description: "The absolute path where the volume will be mounted",
 					},
 					"item": {
-						Type:     schema.TypeList,
-						Computed: true,
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: volumeItemDesc,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"path": {
@@ -434,6 +595,31 @@ func AccessPolicyDataSourceSchema() map[string]*schema.Schema {
 									Computed:    true,
 									Description: "The type of delivery item mapping (key or template)",
 								},
+								"mode": fileModeDataSourceSchema(),
+								"uid":  fileOwnerDataSourceSchema(fileUIDDesc),
+								"gid":  fileOwnerDataSourceSchema(fileGIDDesc),
+							},
+						},
+					},
+					"render_as": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: volumeRenderAsDesc,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The relative file path within the mount point",
+								},
+								"format": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: volumeRenderFormatDesc,
+								},
+								"mode": fileModeDataSourceSchema(),
+								"uid":  fileOwnerDataSourceSchema(fileUIDDesc),
+								"gid":  fileOwnerDataSourceSchema(fileGIDDesc),
 							},
 						},
 					},
@@ -536,6 +722,7 @@ func AccessPolicyDataSourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"deployment_status": deploymentStatusSchema(),
 		"status": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -590,6 +777,10 @@ func setAccessPolicyFields(d *schema.ResourceData, policy *client.AccessPolicy)
 		"attestation_criteria": flattenAttestationCriteria(policy.AttestationCriteria),
 		"status":               policy.Status,
 		"status_detail":        policy.StatusDetail,
+		"not_before":           policy.NotBefore,
+		"expires_at":           policy.ExpiresAt,
+		"schedule":             flattenSchedule(policy.Schedule),
+		"effective_enabled":    effectiveEnabled(policy, time.Now()),
 	}
 
 	for field, value := range fields {
@@ -598,10 +789,46 @@ func setAccessPolicyFields(d *schema.ResourceData, policy *client.AccessPolicy)
 		}
 	}
 
-	if diags := flattenDeliveryConfig(d, policy.DeliveryConfig); diags.HasError() {
+	if diags := flattenDeliveryConfig(d, deliveryConfigsOf(policy)); diags.HasError() {
 		return diags
 	}
 
+	if err := d.Set("deployment_status", flattenDeploymentStatuses(policy.DeploymentStatuses)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set deployment_status: %w", err))
+	}
+
+	return nil
+}
+
+func flattenDeploymentStatuses(statuses []client.AccessPolicyDeploymentStatus) []any {
+	result := make([]any, len(statuses))
+	for i, s := range statuses {
+		result[i] = map[string]any{
+			"deployment_id":  s.DeploymentID,
+			"status":         s.Status,
+			"detail":         s.StatusDetail,
+			"last_synced_at": s.LastSyncedAt,
+		}
+	}
+	return result
+}
+
+// checkRequiredDeployments refuses a required deployment the policy does not
+// apply to, which the wait would otherwise poll for until it timed out. An ID
+// unknown at plan time reads as empty and is skipped.
+func checkRequiredDeployments(required, deploymentIDs []string) error {
+	applies := make(map[string]struct{}, len(deploymentIDs))
+	for _, id := range deploymentIDs {
+		applies[id] = struct{}{}
+	}
+	for _, id := range required {
+		if id == "" {
+			continue
+		}
+		if _, ok := applies[id]; !ok {
+			return fmt.Errorf("required_deployments: %s is not one of deployment_ids", id)
+		}
+	}
 	return nil
 }
 
@@ -629,27 +856,43 @@ func expandAttestationCriteria(list []any) []client.AttestationCriterion {
 	return result
 }
 
-func expandDeliveryConfig(d *schema.ResourceData) any {
+// expandDeliveryConfig collects every delivery block set in configuration into
+// the list the API stores, in schema order so repeated plans send the same body.
+func expandDeliveryConfig(d *schema.ResourceData) []any {
+	var configs []any
 	if v, ok := d.GetOk("env_delivery_config"); ok {
-		return expandEnvDeliveryConfig(v.([]any))
+		if config := expandEnvDeliveryConfig(v.([]any)); config != nil {
+			configs = append(configs, config)
+		}
 	}
 	if v, ok := d.GetOk("volume_delivery_config"); ok {
-		return expandVolumeDeliveryConfig(v.([]any))
+		raw := rawAttr(d.GetRawConfig(), "volume_delivery_config")
+		if config := expandVolumeDeliveryConfig(v.([]any), raw); config != nil {
+			configs = append(configs, config)
+		}
 	}
 	if v, ok := d.GetOk("aws_wif_delivery_config"); ok {
-		return expandAwsWifDeliveryConfig(v.([]any))
+		if config := expandAwsWifDeliveryConfig(v.([]any)); config != nil {
+			configs = append(configs, config)
+		}
 	}
 	if v, ok := d.GetOk("gcp_wif_delivery_config"); ok {
-		return expandGcpWifDeliveryConfig(v.([]any))
+		if config := expandGcpWifDeliveryConfig(v.([]any)); config != nil {
+			configs = append(configs, config)
+		}
 	}
 	if v, ok := d.GetOk("azure_wif_delivery_config"); ok {
-		return expandAzureWifDeliveryConfig(v.([]any))
+		if config := expandAzureWifDeliveryConfig(v.([]any)); config != nil {
+			configs = append(configs, config)
+		}
 	}
 	if v, ok := d.GetOk("sdk_delivery_config"); ok {
-		return expandSdkDeliveryConfig(v.([]any))
+		if config := expandSdkDeliveryConfig(v.([]any)); config != nil {
+			configs = append(configs, config)
+		}
 	}
 
-	return nil
+	return configs
 }
 
 func expandEnvDeliveryConfig(list []any) *client.EnvDeliveryConfig {
@@ -678,13 +921,17 @@ func expandEnvDeliveryConfig(list []any) *client.EnvDeliveryConfig {
 	}
 }
 
-func expandVolumeDeliveryConfig(list []any) *client.VolumeDeliveryConfig {
+// expandVolumeDeliveryConfig expands the volume block. raw is the block's raw
+// configuration, which tells an unset uid or gid from one set to 0; without
+// it, 0 is taken as unset.
+func expandVolumeDeliveryConfig(list []any, raw cty.Value) *client.VolumeDeliveryConfig {
 	if len(list) == 0 || list[0] == nil {
 		return nil
 	}
 
 	configMap := list[0].(map[string]any)
-	rawItems := configMap["item"].([]any)
+	rawItems, _ := configMap["item"].([]any)
+	rawBlock := rawElem(raw, 0)
 
 	items := make([]client.VolumeDeliveryItem, len(rawItems))
 	for i, item := range rawItems {
@@ -698,14 +945,63 @@ func expandVolumeDeliveryConfig(list []any) *client.VolumeDeliveryConfig {
 		if t, ok := itemMap["type"].(string); ok && t != "" {
 			deliveryItem.Type = client.DeliveryMappingType(t)
 		}
+		deliveryItem.Mode, deliveryItem.UID, deliveryItem.GID = expandFileOptions(itemMap, rawElem(rawAttr(rawBlock, "item"), i))
 		items[i] = deliveryItem
 	}
 
-	return &client.VolumeDeliveryConfig{
+	config := &client.VolumeDeliveryConfig{
 		Type:       client.DeliveryTypeVolume,
 		MountPoint: configMap["mount_point"].(string),
 		Items:      items,
 	}
+
+	if rawRenderAs, ok := configMap["render_as"].([]any); ok && len(rawRenderAs) > 0 && rawRenderAs[0] != nil {
+		renderAsMap := rawRenderAs[0].(map[string]any)
+		renderAs := &client.VolumeRenderAs{
+			Path:   renderAsMap["path"].(string),
+			Format: client.VolumeRenderFormat(renderAsMap["format"].(string)),
+		}
+		renderAs.Mode, renderAs.UID, renderAs.GID = expandFileOptions(renderAsMap, rawElem(rawAttr(rawBlock, "render_as"), 0))
+		config.RenderAs = renderAs
+	}
+
+	return config
+}
+
+// expandFileOptions reads the optional mode, uid and gid of a volume file. A
+// uid or gid is only returned when raw, the file's raw configuration, sets it.
+func expandFileOptions(m map[string]any, raw cty.Value) (mode string, uid, gid *int) {
+	mode, _ = m["mode"].(string)
+	owner := func(field string) *int {
+		v, _ := m[field].(int)
+		if raw.IsNull() || !raw.IsKnown() {
+			if v == 0 {
+				return nil
+			}
+		} else if rawAttr(raw, field).IsNull() {
+			return nil
+		}
+		return &v
+	}
+	return mode, owner("uid"), owner("gid")
+}
+
+// rawAttr returns the named attribute of a raw config object, or a null value
+// when obj is null, unknown or has no such attribute.
+func rawAttr(obj cty.Value, name string) cty.Value {
+	if obj.IsNull() || !obj.IsKnown() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return cty.NilVal
+	}
+	return obj.GetAttr(name)
+}
+
+// rawElem returns element i of a raw config list, or a null value when list
+// is null, unknown or shorter.
+func rawElem(list cty.Value, i int) cty.Value {
+	if list.IsNull() || !list.IsKnown() || !list.CanIterateElements() || list.LengthInt() <= i {
+		return cty.NilVal
+	}
+	return list.Index(cty.NumberIntVal(int64(i)))
 }
 
 // WIF shared helpers
@@ -859,38 +1155,57 @@ func flattenAttestationCriteria(criteria []client.AttestationCriterion) []any {
 	return result
 }
 
-func flattenDeliveryConfig(d *schema.ResourceData, config any) diag.Diagnostics {
-	configMap, ok := config.(map[string]any)
-	if !ok {
-		return nil
+// deliveryConfigsOf returns whichever delivery field the policy holds. The list
+// is preferred and the singular field is the fallback, so a policy this
+// provider has not written since the list was introduced reads back as one
+// block and produces no diff against a configuration that declares one.
+func deliveryConfigsOf(policy *client.AccessPolicy) []any {
+	if len(policy.DeliveryConfigs) > 0 {
+		return policy.DeliveryConfigs
 	}
+	if policy.DeliveryConfig != nil {
+		return []any{policy.DeliveryConfig}
+	}
+	return nil
+}
 
-	deliveryType, _ := configMap["type"].(string)
+// flattenDeliveryConfig sorts the policy's delivery configs into their blocks
+// by type. Every block is set, empty when the policy holds no config of its
+// type, so a block removed outside Terraform does not linger in state.
+func flattenDeliveryConfig(d *schema.ResourceData, configs []any) diag.Diagnostics {
+	blocks := make(map[string][]any, len(deliveryConfigAtLeastOneOf))
+	for _, field := range deliveryConfigAtLeastOneOf {
+		blocks[field] = []any{}
+	}
 
-	switch client.DeliveryType(deliveryType) {
-	case client.DeliveryTypeEnv:
-		if err := d.Set("env_delivery_config", configMap["items"]); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set env_delivery_config: %w", err))
-		}
-	case client.DeliveryTypeVolume:
-		if err := d.Set("volume_delivery_config", flattenVolumeDeliveryConfig(configMap)); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set volume_delivery_config: %w", err))
+	for _, config := range configs {
+		configMap, ok := config.(map[string]any)
+		if !ok {
+			continue
 		}
-	case client.DeliveryTypeAwsWif:
-		if err := d.Set("aws_wif_delivery_config", flattenAwsWifDeliveryConfig(configMap)); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set aws_wif_delivery_config: %w", err))
-		}
-	case client.DeliveryTypeGcpWif:
-		if err := d.Set("gcp_wif_delivery_config", flattenGcpWifDeliveryConfig(configMap)); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set gcp_wif_delivery_config: %w", err))
-		}
-	case client.DeliveryTypeAzureWif:
-		if err := d.Set("azure_wif_delivery_config", flattenAzureWifDeliveryConfig(configMap)); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set azure_wif_delivery_config: %w", err))
+
+		deliveryType, _ := configMap["type"].(string)
+
+		switch client.DeliveryType(deliveryType) {
+		case client.DeliveryTypeEnv:
+			items, _ := configMap["items"].([]any)
+			blocks["env_delivery_config"] = append(blocks["env_delivery_config"], items...)
+		case client.DeliveryTypeVolume:
+			blocks["volume_delivery_config"] = append(blocks["volume_delivery_config"], flattenVolumeDeliveryConfig(configMap)...)
+		case client.DeliveryTypeAwsWif:
+			blocks["aws_wif_delivery_config"] = append(blocks["aws_wif_delivery_config"], flattenAwsWifDeliveryConfig(configMap)...)
+		case client.DeliveryTypeGcpWif:
+			blocks["gcp_wif_delivery_config"] = append(blocks["gcp_wif_delivery_config"], flattenGcpWifDeliveryConfig(configMap)...)
+		case client.DeliveryTypeAzureWif:
+			blocks["azure_wif_delivery_config"] = append(blocks["azure_wif_delivery_config"], flattenAzureWifDeliveryConfig(configMap)...)
+		case client.DeliveryTypeSdk:
+			blocks["sdk_delivery_config"] = append(blocks["sdk_delivery_config"], flattenSdkDeliveryConfig(configMap)...)
 		}
-	case client.DeliveryTypeSdk:
-		if err := d.Set("sdk_delivery_config", flattenSdkDeliveryConfig(configMap)); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set sdk_delivery_config: %w", err))
+	}
+
+	for _, field := range deliveryConfigAtLeastOneOf {
+		if err := d.Set(field, blocks[field]); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set %s: %w", field, err))
 		}
 	}
 
@@ -902,18 +1217,43 @@ func flattenVolumeDeliveryConfig(configMap map[string]any) []any {
 	items := make([]any, len(rawItems))
 	for i, item := range rawItems {
 		itemMap, _ := item.(map[string]any)
-		items[i] = map[string]any{
+		flattened := map[string]any{
 			"path": itemMap["path"],
 			"key":  itemMap["key"],
 			"type": itemMap["type"],
 		}
+		flattenFileOptions(itemMap, flattened)
+		items[i] = flattened
 	}
 
-	return []any{
-		map[string]any{
-			"mount_point": configMap["mount_point"],
-			"item":        items,
-		},
+	result := map[string]any{
+		"mount_point": configMap["mount_point"],
+		"item":        items,
+	}
+
+	if renderAsMap, ok := configMap["render_as"].(map[string]any); ok {
+		renderAs := map[string]any{
+			"path":   renderAsMap["path"],
+			"format": renderAsMap["format"],
+		}
+		flattenFileOptions(renderAsMap, renderAs)
+		result["render_as"] = []any{renderAs}
+	}
+
+	return []any{result}
+}
+
+// flattenFileOptions copies the mode, uid and gid of a volume file into result.
+// JSON decodes the IDs as float64.
+func flattenFileOptions(configMap map[string]any, result map[string]any) {
+	result["mode"] = configMap["mode"]
+	for _, field := range []string{"uid", "gid"} {
+		switch v := configMap[field].(type) {
+		case float64:
+			result[field] = int(v)
+		case int:
+			result[field] = v
+		}
 	}
 }
 
@@ -1,8 +1,13 @@
 package access_policy
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 )
 
@@ -25,7 +30,7 @@ func TestExpandVolumeDeliveryConfig(t *testing.T) {
 		},
 	}
 
-	result := expandVolumeDeliveryConfig(input)
+	result := expandVolumeDeliveryConfig(input, cty.NilVal)
 
 	if result == nil {
 		t.Fatal("expected non-nil result")
@@ -70,7 +75,7 @@ func TestExpandVolumeDeliveryConfig_template(t *testing.T) {
 		},
 	}
 
-	result := expandVolumeDeliveryConfig(input)
+	result := expandVolumeDeliveryConfig(input, cty.NilVal)
 
 	if result == nil {
 		t.Fatal("expected non-nil result")
@@ -84,12 +89,12 @@ func TestExpandVolumeDeliveryConfig_template(t *testing.T) {
 }
 
 func TestExpandVolumeDeliveryConfig_nil(t *testing.T) {
-	result := expandVolumeDeliveryConfig([]any{})
+	result := expandVolumeDeliveryConfig([]any{}, cty.NilVal)
 	if result != nil {
 		t.Errorf("expected nil result for empty input, got %+v", result)
 	}
 
-	result = expandVolumeDeliveryConfig([]any{nil})
+	result = expandVolumeDeliveryConfig([]any{nil}, cty.NilVal)
 	if result != nil {
 		t.Errorf("expected nil result for nil element, got %+v", result)
 	}
@@ -430,3 +435,454 @@ func TestFlattenGcpWifDeliveryConfig(t *testing.T) {
 		t.Errorf("expected service_account_token_lifetime %d, got %v", 7200, configMap["service_account_token_lifetime"])
 	}
 }
+
+func TestExpandDeliveryConfig_combined(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"env_delivery_config": []any{
+			map[string]any{"name": "DB_PASSWORD", "key": "password", "type": "key"},
+		},
+		"volume_delivery_config": []any{
+			map[string]any{
+				"mount_point": "/etc/secrets",
+				"item": []any{
+					map[string]any{"path": "db_password", "key": "password", "type": "key"},
+				},
+			},
+		},
+	})
+
+	result := expandDeliveryConfig(d)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 delivery configs, got %d", len(result))
+	}
+	if _, ok := result[0].(*client.EnvDeliveryConfig); !ok {
+		t.Errorf("expected result[0] to be *client.EnvDeliveryConfig, got %T", result[0])
+	}
+	if _, ok := result[1].(*client.VolumeDeliveryConfig); !ok {
+		t.Errorf("expected result[1] to be *client.VolumeDeliveryConfig, got %T", result[1])
+	}
+}
+
+func TestExpandDeliveryConfig_none(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{})
+
+	if result := expandDeliveryConfig(d); result != nil {
+		t.Errorf("expected nil result, got %+v", result)
+	}
+}
+
+// What is sent has to read back into the same blocks, or every plan after the
+// first would show a diff.
+func TestFlattenDeliveryConfig_roundTrip(t *testing.T) {
+	raw := map[string]any{
+		"env_delivery_config": []any{
+			map[string]any{"name": "DB_PASSWORD", "key": "password", "type": "key"},
+			map[string]any{"name": "DB_URL", "key": "postgresql://${username}@host/db", "type": "template"},
+		},
+		"volume_delivery_config": []any{
+			map[string]any{
+				"mount_point": "/etc/secrets",
+				"item": []any{
+					map[string]any{"path": "db_password", "key": "password", "type": "key", "mode": "0400", "uid": 1000, "gid": 1000},
+				},
+				"render_as": []any{
+					map[string]any{"path": "app.env", "format": "env", "mode": "0440"},
+				},
+			},
+		},
+		"sdk_delivery_config": []any{
+			map[string]any{
+				"secret_name": "prod/db",
+				"items": []any{
+					map[string]any{"name": "password", "key": "password", "type": "key"},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), raw)
+
+	// Decode through JSON as the API response would be.
+	body, err := json.Marshal(expandDeliveryConfig(d))
+	if err != nil {
+		t.Fatalf("failed to marshal delivery configs: %v", err)
+	}
+	var configs []any
+	if err := json.Unmarshal(body, &configs); err != nil {
+		t.Fatalf("failed to unmarshal delivery configs: %v", err)
+	}
+
+	read := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{})
+	if diags := flattenDeliveryConfig(read, configs); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for _, field := range deliveryConfigAtLeastOneOf {
+		if !reflect.DeepEqual(read.Get(field), d.Get(field)) {
+			t.Errorf("%s did not round-trip: expected %v, got %v", field, d.Get(field), read.Get(field))
+		}
+	}
+}
+
+// A uid or gid of 0 (root) is sent and reads back as 0, while one left unset
+// is not sent, keeping the agent's default.
+func TestFlattenDeliveryConfig_rootOwnerRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"volume_delivery_config": []any{
+			map[string]any{
+				"mount_point": "/etc/secrets",
+				"item": []any{
+					map[string]any{"path": "db_password", "key": "password", "type": "key", "uid": 0, "gid": 0},
+					map[string]any{"path": "api_key", "key": "secret", "type": "key"},
+				},
+				"render_as": []any{
+					map[string]any{"path": "app.env", "format": "env", "uid": 0},
+				},
+			},
+		},
+	})
+
+	// The raw configuration Terraform sends, with unset attributes null.
+	file := func(path, key string, uid, gid cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"path": cty.StringVal(path), "key": cty.StringVal(key), "type": cty.StringVal("key"),
+			"mode": cty.NullVal(cty.String), "uid": uid, "gid": gid,
+		})
+	}
+	zero, unset := cty.NumberIntVal(0), cty.NullVal(cty.Number)
+	raw := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"mount_point": cty.StringVal("/etc/secrets"),
+		"item": cty.ListVal([]cty.Value{
+			file("db_password", "password", zero, zero),
+			file("api_key", "secret", unset, unset),
+		}),
+		"render_as": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"path": cty.StringVal("app.env"), "format": cty.StringVal("env"),
+			"mode": cty.NullVal(cty.String), "uid": zero, "gid": unset,
+		})}),
+	})})
+
+	volume := expandVolumeDeliveryConfig(d.Get("volume_delivery_config").([]any), raw)
+	configs := []any{volume}
+	if volume.Items[0].UID == nil || *volume.Items[0].UID != 0 || volume.Items[0].GID == nil || *volume.Items[0].GID != 0 {
+		t.Errorf("expected uid and gid 0 to be sent, got %v and %v", volume.Items[0].UID, volume.Items[0].GID)
+	}
+	if volume.Items[1].UID != nil || volume.Items[1].GID != nil {
+		t.Errorf("expected an unset uid and gid not to be sent, got %v and %v", volume.Items[1].UID, volume.Items[1].GID)
+	}
+	if volume.RenderAs.UID == nil || *volume.RenderAs.UID != 0 || volume.RenderAs.GID != nil {
+		t.Errorf("expected render_as uid 0 and no gid, got %v and %v", volume.RenderAs.UID, volume.RenderAs.GID)
+	}
+
+	body, err := json.Marshal(configs)
+	if err != nil {
+		t.Fatalf("failed to marshal delivery configs: %v", err)
+	}
+	var decoded []any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal delivery configs: %v", err)
+	}
+	item := decoded[0].(map[string]any)["items"].([]any)[0].(map[string]any)
+	if item["uid"] != float64(0) || item["gid"] != float64(0) {
+		t.Errorf("expected uid and gid 0 in the request body, got %s", body)
+	}
+
+	read := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{})
+	if diags := flattenDeliveryConfig(read, decoded); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !reflect.DeepEqual(read.Get("volume_delivery_config"), d.Get("volume_delivery_config")) {
+		t.Errorf("volume_delivery_config did not round-trip: expected %v, got %v",
+			d.Get("volume_delivery_config"), read.Get("volume_delivery_config"))
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// A block no longer held by the policy has to be emptied, not left in state.
+func TestFlattenDeliveryConfig_clearsAbsentBlocks(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"env_delivery_config": []any{
+			map[string]any{"name": "DB_PASSWORD", "key": "password", "type": "key"},
+		},
+	})
+
+	configs := []any{
+		map[string]any{
+			"type":        "volume",
+			"mount_point": "/etc/secrets",
+			"items": []any{
+				map[string]any{"path": "db_password", "key": "password", "type": "key"},
+			},
+		},
+	}
+	if diags := flattenDeliveryConfig(d, configs); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if env := d.Get("env_delivery_config").([]any); len(env) != 0 {
+		t.Errorf("expected env_delivery_config to be cleared, got %v", env)
+	}
+	if volume := d.Get("volume_delivery_config").([]any); len(volume) != 1 {
+		t.Errorf("expected 1 volume_delivery_config block, got %d", len(volume))
+	}
+}
+
+// A policy this provider has not written since the list was introduced still
+// answers through the singular field.
+func TestDeliveryConfigsOf_fallsBackToSingular(t *testing.T) {
+	single := map[string]any{"type": "env", "items": []any{}}
+
+	result := deliveryConfigsOf(&client.AccessPolicy{DeliveryConfig: single})
+	if len(result) != 1 {
+		t.Fatalf("expected 1 delivery config, got %d", len(result))
+	}
+
+	listed := []any{single, map[string]any{"type": "volume"}}
+	result = deliveryConfigsOf(&client.AccessPolicy{DeliveryConfig: single, DeliveryConfigs: listed})
+	if len(result) != 2 {
+		t.Errorf("expected the list to be preferred, got %d configs", len(result))
+	}
+
+	if result := deliveryConfigsOf(&client.AccessPolicy{}); result != nil {
+		t.Errorf("expected nil result, got %+v", result)
+	}
+}
+
+func TestCheckRequiredDeployments(t *testing.T) {
+	deploymentIDs := []string{"dep-prod", "dep-dev"}
+
+	if err := checkRequiredDeployments([]string{"dep-prod"}, deploymentIDs); err != nil {
+		t.Errorf("unexpected error for a subset: %v", err)
+	}
+	if err := checkRequiredDeployments(nil, deploymentIDs); err != nil {
+		t.Errorf("unexpected error with none required: %v", err)
+	}
+	if err := checkRequiredDeployments([]string{""}, deploymentIDs); err != nil {
+		t.Errorf("unexpected error for an ID unknown at plan time: %v", err)
+	}
+	if err := checkRequiredDeployments([]string{"dep-staging"}, deploymentIDs); err == nil {
+		t.Error("expected an error for a deployment outside deployment_ids")
+	}
+}
+
+func TestWarnRequiredDeploymentsUnchecked(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"deployment_ids":       []any{"dep-prod", "dep-dev"},
+		"required_deployments": []any{"dep-prod"},
+	})
+	if diags := warnRequiredDeploymentsUnchecked(d, nil); len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning when no deployment status is reported, got %v", diags)
+	}
+
+	statuses := flattenDeploymentStatuses([]client.AccessPolicyDeploymentStatus{{DeploymentID: "dep-prod", Status: "ok"}})
+	if err := d.Set("deployment_status", statuses); err != nil {
+		t.Fatalf("failed to set deployment_status: %v", err)
+	}
+	if diags := warnRequiredDeploymentsUnchecked(d, nil); len(diags) != 0 {
+		t.Errorf("expected no warning once deployment status is reported, got %v", diags)
+	}
+
+	none := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"deployment_ids": []any{"dep-prod"},
+	})
+	if diags := warnRequiredDeploymentsUnchecked(none, nil); len(diags) != 0 {
+		t.Errorf("expected no warning without required_deployments, got %v", diags)
+	}
+}
+
+func TestFlattenDeploymentStatuses(t *testing.T) {
+	result := flattenDeploymentStatuses([]client.AccessPolicyDeploymentStatus{
+		{DeploymentID: "dep-prod", Status: "ok", LastSyncedAt: "2026-10-01T12:00:00Z"},
+		{DeploymentID: "dep-dev", Status: "error", StatusDetail: "agent unreachable"},
+	})
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(result))
+	}
+	first := result[0].(map[string]any)
+	if first["deployment_id"] != "dep-prod" {
+		t.Errorf("expected deployment_id %q, got %q", "dep-prod", first["deployment_id"])
+	}
+	if first["last_synced_at"] != "2026-10-01T12:00:00Z" {
+		t.Errorf("expected last_synced_at %q, got %q", "2026-10-01T12:00:00Z", first["last_synced_at"])
+	}
+	second := result[1].(map[string]any)
+	if second["status"] != "error" {
+		t.Errorf("expected status %q, got %q", "error", second["status"])
+	}
+	if second["detail"] != "agent unreachable" {
+		t.Errorf("expected detail %q, got %q", "agent unreachable", second["detail"])
+	}
+}
+
+func TestExpandVolumeDeliveryConfig_fileOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     map[string]any
+		wantMode string
+		wantUID  *int
+		wantGID  *int
+	}{
+		{
+			name:     "mode and owner",
+			item:     map[string]any{"path": "db_password", "key": "password", "type": "key", "mode": "0400", "uid": 1000, "gid": 2000},
+			wantMode: "0400",
+			wantUID:  intPtr(1000),
+			wantGID:  intPtr(2000),
+		},
+		{
+			name:     "mode only",
+			item:     map[string]any{"path": "db_password", "key": "password", "type": "key", "mode": "0440"},
+			wantMode: "0440",
+		},
+		{
+			name: "agent defaults",
+			item: map[string]any{"path": "db_password", "key": "password", "type": "key"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := expandVolumeDeliveryConfig([]any{
+				map[string]any{"mount_point": "/etc/secrets", "item": []any{tc.item}},
+			}, cty.NilVal)
+
+			if result == nil {
+				t.Fatal("expected non-nil result")
+			}
+			item := result.Items[0]
+			if item.Mode != tc.wantMode {
+				t.Errorf("expected mode %q, got %q", tc.wantMode, item.Mode)
+			}
+			if !reflect.DeepEqual(item.UID, tc.wantUID) {
+				t.Errorf("expected uid %v, got %v", tc.wantUID, item.UID)
+			}
+			if !reflect.DeepEqual(item.GID, tc.wantGID) {
+				t.Errorf("expected gid %v, got %v", tc.wantGID, item.GID)
+			}
+			if result.RenderAs != nil {
+				t.Errorf("expected no render_as, got %+v", result.RenderAs)
+			}
+		})
+	}
+}
+
+func TestExpandVolumeDeliveryConfig_renderAs(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+	}{
+		{"env", "env"},
+		{"json", "json"},
+		{"yaml", "yaml"},
+		{"properties", "properties"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := expandVolumeDeliveryConfig([]any{
+				map[string]any{
+					"mount_point": "/etc/secrets",
+					"render_as": []any{
+						map[string]any{"path": "credential." + tc.format, "format": tc.format, "mode": "0400", "uid": 1000, "gid": 0},
+					},
+				},
+			}, cty.NilVal)
+
+			if result == nil {
+				t.Fatal("expected non-nil result")
+			}
+			if len(result.Items) != 0 {
+				t.Errorf("expected no items, got %d", len(result.Items))
+			}
+			if result.RenderAs == nil {
+				t.Fatal("expected render_as to be set")
+			}
+			if result.RenderAs.Format != client.VolumeRenderFormat(tc.format) {
+				t.Errorf("expected format %q, got %q", tc.format, result.RenderAs.Format)
+			}
+			if result.RenderAs.Path != "credential."+tc.format {
+				t.Errorf("expected path %q, got %q", "credential."+tc.format, result.RenderAs.Path)
+			}
+			if result.RenderAs.Mode != "0400" {
+				t.Errorf("expected mode %q, got %q", "0400", result.RenderAs.Mode)
+			}
+			if result.RenderAs.UID == nil || *result.RenderAs.UID != 1000 {
+				t.Errorf("expected uid %d, got %v", 1000, result.RenderAs.UID)
+			}
+		})
+	}
+}
+
+func TestFlattenVolumeDeliveryConfig_fileOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        map[string]any
+		wantItemMode any
+		wantItemUID  any
+		wantRenderAs bool
+	}{
+		{
+			name: "item options decoded from JSON",
+			input: map[string]any{
+				"type":        "volume",
+				"mount_point": "/etc/secrets",
+				"items": []any{
+					map[string]any{"path": "db_password", "key": "password", "type": "key", "mode": "0400", "uid": float64(1000), "gid": float64(2000)},
+				},
+			},
+			wantItemMode: "0400",
+			wantItemUID:  1000,
+		},
+		{
+			name: "render_as",
+			input: map[string]any{
+				"type":        "volume",
+				"mount_point": "/etc/secrets",
+				"items": []any{
+					map[string]any{"path": "db_password", "key": "password", "type": "key"},
+				},
+				"render_as": map[string]any{"path": "app.env", "format": "env", "mode": "0440", "gid": float64(3000)},
+			},
+			wantItemMode: nil,
+			wantItemUID:  nil,
+			wantRenderAs: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := flattenVolumeDeliveryConfig(tc.input)
+
+			configMap := result[0].(map[string]any)
+			item := configMap["item"].([]any)[0].(map[string]any)
+			if item["mode"] != tc.wantItemMode {
+				t.Errorf("expected item mode %v, got %v", tc.wantItemMode, item["mode"])
+			}
+			if item["uid"] != tc.wantItemUID {
+				t.Errorf("expected item uid %v, got %v", tc.wantItemUID, item["uid"])
+			}
+
+			renderAs, ok := configMap["render_as"].([]any)
+			if ok != tc.wantRenderAs {
+				t.Fatalf("expected render_as present %v, got %v", tc.wantRenderAs, ok)
+			}
+			if !ok {
+				return
+			}
+			renderAsMap := renderAs[0].(map[string]any)
+			if renderAsMap["format"] != "env" {
+				t.Errorf("expected render_as format %q, got %v", "env", renderAsMap["format"])
+			}
+			if renderAsMap["mode"] != "0440" {
+				t.Errorf("expected render_as mode %q, got %v", "0440", renderAsMap["mode"])
+			}
+			if renderAsMap["gid"] != 3000 {
+				t.Errorf("expected render_as gid %d, got %v", 3000, renderAsMap["gid"])
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package access_policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+// privilegeCredentialTypes maps each privilege type to the credential types it
+// applies to. Most apply to the credential type of the same name; MySQL
+// privileges also serve MariaDB credentials, which have no privilege type of
+// their own.
+var privilegeCredentialTypes = map[string][]client.AccessCredentialType{
+	"postgres":            {client.AccessCredentialTypePostgres},
+	"mongodb":             {client.AccessCredentialTypeMongoDB},
+	"mongodb_atlas":       {client.AccessCredentialTypeMongoDBAtlas},
+	"mysql":               {client.AccessCredentialTypeMySQL, client.AccessCredentialTypeMariaDB},
+	"mssql":               {client.AccessCredentialTypeMSSQL},
+	"oracle":              {client.AccessCredentialTypeOracle},
+	"openai":              {client.AccessCredentialTypeOpenAI},
+	"grok":                {client.AccessCredentialTypeGrok},
+	"redis":               {client.AccessCredentialTypeRedis},
+	"apigee":              {client.AccessCredentialTypeApigee},
+	"elasticsearch":       {client.AccessCredentialTypeElasticsearch},
+	"rabbitmq":            {client.AccessCredentialTypeRabbitmq},
+	"gcp_sa":              {client.AccessCredentialTypeGCPSA},
+	"gcp_service_account": {client.AccessCredentialTypeGCPSA},
+	"azure_app":           {client.AccessCredentialTypeAzureApp},
+	"aws_access_key":      {client.AccessCredentialTypeAWSAccessKey},
+	"twilio":              {client.AccessCredentialTypeTwilio},
+	"datadog":             {client.AccessCredentialTypeDatadog},
+	"snowflake":           {client.AccessCredentialTypeSnowflake},
+	"gitlab":              {client.AccessCredentialTypeGitlab},
+	"salesforce":          {client.AccessCredentialTypeSalesforce},
+	"sendgrid":            {client.AccessCredentialTypeSendGrid},
+	"temporal_cloud":      {client.AccessCredentialTypeTemporalCloud},
+	"kafka":               {client.AccessCredentialTypeKafka},
+	"clickhouse":          {client.AccessCredentialTypeClickHouse},
+	"cassandra":           {client.AccessCredentialTypeCassandra},
+	"anthropic":           {client.AccessCredentialTypeAnthropic},
+	"azure_openai":        {client.AccessCredentialTypeAzureOpenAI},
+}
+
+// privilegeAppliesTo reports whether a privilege of type privilegeType can be
+// paired with a credential of type credentialType. Only a definite mismatch is
+// refused: a privilege type missing from privilegeCredentialTypes, or a
+// credential type no privilege type lists, is left to the API.
+func privilegeAppliesTo(privilegeType string, credentialType client.AccessCredentialType) bool {
+	types, ok := privilegeCredentialTypes[privilegeType]
+	if !ok || slices.Contains(types, credentialType) {
+		return true
+	}
+	for _, other := range privilegeCredentialTypes {
+		if slices.Contains(other, credentialType) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCredentialCompatibility refuses privileges that do not apply to the
+// credential's type and deployments the credential is not available in. A
+// type or deployment list the API did not report is not checked.
+func checkCredentialCompatibility(cred *client.AccessCredential, privileges []*client.AccessPrivilege, deploymentIDs []string) error {
+	for _, privilege := range privileges {
+		if privilege.Type == "" || cred.Type == "" {
+			continue
+		}
+		if !privilegeAppliesTo(privilege.Type, cred.Type) {
+			return fmt.Errorf("access_privilege_ids: %s is a %s access privilege and cannot be paired with %s, a %s access credential",
+				privilege.ID, privilege.Type, cred.ID, cred.Type)
+		}
+	}
+
+	if len(cred.DeploymentIDs) == 0 {
+		return nil
+	}
+	available := make(map[string]struct{}, len(cred.DeploymentIDs))
+	for _, id := range cred.DeploymentIDs {
+		available[id] = struct{}{}
+	}
+	var missing []string
+	for _, id := range deploymentIDs {
+		// An ID unknown at plan time reads as empty.
+		if id == "" {
+			continue
+		}
+		if _, ok := available[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("deployment_ids: access credential %s is not available in %s (it is available in %s)",
+			cred.ID, strings.Join(missing, ", "), strings.Join(cred.DeploymentIDs, ", "))
+	}
+
+	return nil
+}
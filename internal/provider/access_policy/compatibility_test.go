@@ -0,0 +1,124 @@
+package access_policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestCheckCredentialCompatibility(t *testing.T) {
+	postgres := &client.AccessCredential{
+		ID:            "acr-postgres",
+		Type:          client.AccessCredentialTypePostgres,
+		DeploymentIDs: []string{"dep-prod", "dep-staging"},
+	}
+	redis := &client.AccessCredential{
+		ID:            "acr-redis",
+		Type:          client.AccessCredentialTypeRedis,
+		DeploymentIDs: []string{"dep-prod"},
+	}
+	gcpSA := &client.AccessCredential{
+		ID:   "acr-gcp",
+		Type: client.AccessCredentialTypeGCPSA,
+	}
+
+	cases := []struct {
+		name          string
+		cred          *client.AccessCredential
+		privileges    []*client.AccessPrivilege
+		deploymentIDs []string
+		wantErr       string
+	}{
+		{"matching type and deployment", postgres,
+			[]*client.AccessPrivilege{{ID: "apr-1", Type: "postgres"}}, []string{"dep-prod"}, ""},
+		{"no privileges", redis, nil, []string{"dep-prod"}, ""},
+		{"mismatched type", redis,
+			[]*client.AccessPrivilege{{ID: "apr-1", Type: "postgres"}}, []string{"dep-prod"},
+			"apr-1 is a postgres access privilege and cannot be paired with acr-redis, a redis access credential"},
+		{"aliased type", gcpSA,
+			[]*client.AccessPrivilege{{ID: "apr-1", Type: "gcp_sa"}}, nil, ""},
+		{"privilege type not reported", redis,
+			[]*client.AccessPrivilege{{ID: "apr-1"}}, []string{"dep-prod"}, ""},
+		{"deployment outside the credential", postgres, nil, []string{"dep-dev"},
+			"access credential acr-postgres is not available in dep-dev"},
+		{"deployment unknown at plan time", postgres, nil, []string{""}, ""},
+		// A credential reporting no deployments is not checked.
+		{"credential deployments not reported", gcpSA, nil, []string{"dep-dev"}, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCredentialCompatibility(tc.cred, tc.privileges, tc.deploymentIDs)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPrivilegeAppliesTo(t *testing.T) {
+	// Every privilege resource paired with the credential types it serves.
+	cases := []struct {
+		privilegeType string
+		credentials   []client.AccessCredentialType
+	}{
+		{"postgres", []client.AccessCredentialType{client.AccessCredentialTypePostgres}},
+		{"mongodb", []client.AccessCredentialType{client.AccessCredentialTypeMongoDB}},
+		{"mongodb_atlas", []client.AccessCredentialType{client.AccessCredentialTypeMongoDBAtlas}},
+		{"mysql", []client.AccessCredentialType{client.AccessCredentialTypeMySQL, client.AccessCredentialTypeMariaDB}},
+		{"mssql", []client.AccessCredentialType{client.AccessCredentialTypeMSSQL}},
+		{"oracle", []client.AccessCredentialType{client.AccessCredentialTypeOracle}},
+		{"openai", []client.AccessCredentialType{client.AccessCredentialTypeOpenAI}},
+		{"grok", []client.AccessCredentialType{client.AccessCredentialTypeGrok}},
+		{"redis", []client.AccessCredentialType{client.AccessCredentialTypeRedis}},
+		{"apigee", []client.AccessCredentialType{client.AccessCredentialTypeApigee}},
+		{"elasticsearch", []client.AccessCredentialType{client.AccessCredentialTypeElasticsearch}},
+		{"rabbitmq", []client.AccessCredentialType{client.AccessCredentialTypeRabbitmq}},
+		{"gcp_sa", []client.AccessCredentialType{client.AccessCredentialTypeGCPSA}},
+		{"azure_app", []client.AccessCredentialType{client.AccessCredentialTypeAzureApp}},
+		{"aws_access_key", []client.AccessCredentialType{client.AccessCredentialTypeAWSAccessKey}},
+		{"twilio", []client.AccessCredentialType{client.AccessCredentialTypeTwilio}},
+		{"datadog", []client.AccessCredentialType{client.AccessCredentialTypeDatadog}},
+		{"snowflake", []client.AccessCredentialType{client.AccessCredentialTypeSnowflake}},
+		{"gitlab", []client.AccessCredentialType{client.AccessCredentialTypeGitlab}},
+		{"salesforce", []client.AccessCredentialType{client.AccessCredentialTypeSalesforce}},
+		{"sendgrid", []client.AccessCredentialType{client.AccessCredentialTypeSendGrid}},
+		{"temporal_cloud", []client.AccessCredentialType{client.AccessCredentialTypeTemporalCloud}},
+		{"kafka", []client.AccessCredentialType{client.AccessCredentialTypeKafka}},
+		{"clickhouse", []client.AccessCredentialType{client.AccessCredentialTypeClickHouse}},
+		{"cassandra", []client.AccessCredentialType{client.AccessCredentialTypeCassandra}},
+		{"anthropic", []client.AccessCredentialType{client.AccessCredentialTypeAnthropic}},
+		{"azure_openai", []client.AccessCredentialType{client.AccessCredentialTypeAzureOpenAI}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.privilegeType, func(t *testing.T) {
+			for _, cred := range tc.credentials {
+				if !privilegeAppliesTo(tc.privilegeType, cred) {
+					t.Errorf("%s privilege should apply to %s credentials", tc.privilegeType, cred)
+				}
+			}
+			// Every privilege type is refused for a credential another privilege owns.
+			other := client.AccessCredentialTypePostgres
+			if tc.privilegeType == "postgres" {
+				other = client.AccessCredentialTypeRedis
+			}
+			if privilegeAppliesTo(tc.privilegeType, other) {
+				t.Errorf("%s privilege should not apply to %s credentials", tc.privilegeType, other)
+			}
+		})
+	}
+
+	// Pairings this provider cannot confirm are left to the API.
+	if !privilegeAppliesTo("some_new_type", client.AccessCredentialTypePostgres) {
+		t.Error("unknown privilege types should not be refused")
+	}
+	if !privilegeAppliesTo("openai", client.AccessCredentialTypeGemini) {
+		t.Error("credential types without a privilege type should not be refused")
+	}
+}
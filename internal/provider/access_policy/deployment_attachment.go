@@ -0,0 +1,275 @@
+package access_policy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	deploymentAttachmentDescription = "Attaches a single deployment to an access policy defined elsewhere, so a cluster module can enroll its deployment without editing the policy's `deployment_ids`. The deployment is added to the policy on create and removed on destroy. A policy with attached deployments must ignore changes to `deployment_ids` on its `hush_access_policy` resource, or each apply undoes the other."
+
+	attachmentPolicyIDDesc     = "The ID of the access policy to attach the deployment to"
+	attachmentDeploymentIDDesc = "The ID of the deployment to attach"
+
+	// attachmentMaxAttempts bounds the read-modify-write cycles spent on a
+	// deployment list another writer keeps changing.
+	attachmentMaxAttempts = 5
+)
+
+// policyDeploymentLocks holds a mutex per policy ID. Terraform applies sibling
+// attachments of one policy in parallel, and without it their cycles would
+// overwrite each other's writes.
+var policyDeploymentLocks sync.Map
+
+func DeploymentAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Description: deploymentAttachmentDescription,
+
+		CreateContext: resourceDeploymentAttachmentCreate,
+		ReadContext:   resourceDeploymentAttachmentRead,
+		DeleteContext: resourceDeploymentAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeploymentAttachmentImport,
+		},
+
+		Schema: DeploymentAttachmentResourceSchema(),
+	}
+}
+
+func DeploymentAttachmentResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_policy_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  attachmentPolicyIDDesc,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^apl-`), "access_policy_id must start with 'apl-'"),
+		},
+		"deployment_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  attachmentDeploymentIDDesc,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+}
+
+func resourceDeploymentAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	policyID := d.Get("access_policy_id").(string)
+	deploymentID := d.Get("deployment_id").(string)
+
+	if err := setPolicyDeployment(ctx, c, policyID, deploymentID, true); err != nil {
+		// A deployment that was added but failed to sync is tracked, so the
+		// failed create taints it rather than leaving it attached unmanaged.
+		if attached, _ := policyHasDeployment(ctx, c, policyID, deploymentID); attached {
+			d.SetId(deploymentAttachmentID(policyID, deploymentID))
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(deploymentAttachmentID(policyID, deploymentID))
+	return resourceDeploymentAttachmentRead(ctx, d, meta)
+}
+
+func resourceDeploymentAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	policyID, deploymentID, err := parseDeploymentAttachmentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attached, err := policyHasDeployment(ctx, c, policyID, deploymentID)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !attached {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("access_policy_id", policyID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set access_policy_id: %w", err))
+	}
+	if err := d.Set("deployment_id", deploymentID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set deployment_id: %w", err))
+	}
+
+	return nil
+}
+
+func resourceDeploymentAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	policyID := d.Get("access_policy_id").(string)
+	deploymentID := d.Get("deployment_id").(string)
+
+	if err := setPolicyDeployment(ctx, c, policyID, deploymentID, false); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		// The wait after a removal is on the policy's overall status, which
+		// the remaining deployments decide. Once this one is gone, their
+		// failure is reported but does not fail the destroy.
+		if attached, getErr := policyHasDeployment(ctx, c, policyID, deploymentID); getErr == nil && !attached {
+			d.SetId("")
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Deployment '%s' was removed from access policy '%s', but the policy did not sync cleanly", deploymentID, policyID),
+				Detail:   err.Error(),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDeploymentAttachmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	policyID, deploymentID, err := parseDeploymentAttachmentID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("access_policy_id", policyID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("deployment_id", deploymentID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// setPolicyDeployment adds the deployment to the policy's deployment list, or
+// removes it, by reading the list, changing it and writing it back. The write
+// is conditional on the list it was computed from: when another writer changed
+// the list in between, the API refuses it and the cycle starts over on the
+// fresh list. A list that already reflects the change is not written.
+//
+// The list is read back after the write and must equal the one written. If it
+// does not, another writer's update raced this one and one of the two may have
+// been lost, which is reported rather than retried, since a retry could not
+// tell which.
+//
+// An addition waits for the added deployment alone to sync. A removal waits
+// for the policy's overall status, since the removed deployment no longer
+// reports one.
+func setPolicyDeployment(ctx context.Context, c *client.Client, policyID, deploymentID string, attach bool) error {
+	mu, _ := policyDeploymentLocks.LoadOrStore(policyID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	var requiredDeployments []string
+	if attach {
+		requiredDeployments = []string{deploymentID}
+	}
+
+	for attempt := 0; ; attempt++ {
+		policy, err := client.GetAccessPolicy(ctx, c, policyID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(policy.DeploymentIDs, deploymentID) == attach {
+			return nil
+		}
+		if attempt == attachmentMaxAttempts {
+			return fmt.Errorf("deployment list of access policy '%s' changed concurrently on each of %d attempts to %s deployment '%s'",
+				policyID, attachmentMaxAttempts, attachmentVerb(attach), deploymentID)
+		}
+
+		deploymentIDs := withoutDeployment(policy.DeploymentIDs, deploymentID)
+		if attach {
+			deploymentIDs = append(deploymentIDs, deploymentID)
+		}
+		expectedIDs := slices.Clone(policy.DeploymentIDs)
+		if expectedIDs == nil {
+			expectedIDs = []string{}
+		}
+		input := &client.UpdateAccessPolicyInput{
+			DeploymentIDs:         &deploymentIDs,
+			ExpectedDeploymentIDs: &expectedIDs,
+		}
+		if _, err := client.UpdateAccessPolicy(ctx, c, policyID, input, requiredDeployments); err != nil {
+			if client.IsConflictError(err) || client.IsPreconditionFailedError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to %s deployment '%s' on access policy '%s': %w",
+				attachmentVerb(attach), deploymentID, policyID, err)
+		}
+
+		written, err := client.GetAccessPolicy(ctx, c, policyID)
+		if err != nil {
+			return err
+		}
+		if !sameDeployments(written.DeploymentIDs, deploymentIDs) {
+			return fmt.Errorf("deployment list of access policy '%s' changed while trying to %s deployment '%s': wrote [%s], read back [%s]. "+
+				"Another writer updated it at the same time and one of the changes may have been lost; check the policy's deployment_ids",
+				policyID, attachmentVerb(attach), deploymentID,
+				strings.Join(deploymentIDs, ", "), strings.Join(written.DeploymentIDs, ", "))
+		}
+		return nil
+	}
+}
+
+func policyHasDeployment(ctx context.Context, c *client.Client, policyID, deploymentID string) (bool, error) {
+	policy, err := client.GetAccessPolicy(ctx, c, policyID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(policy.DeploymentIDs, deploymentID), nil
+}
+
+// withoutDeployment returns a copy of ids with every occurrence of
+// deploymentID removed, leaving the caller's slice untouched.
+func withoutDeployment(ids []string, deploymentID string) []string {
+	result := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		if id != deploymentID {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// sameDeployments reports whether a and b hold the same deployment IDs, in any
+// order.
+func sameDeployments(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func attachmentVerb(attach bool) string {
+	if attach {
+		return "attach"
+	}
+	return "detach"
+}
+
+func deploymentAttachmentID(policyID, deploymentID string) string {
+	return policyID + "/" + deploymentID
+}
+
+func parseDeploymentAttachmentID(id string) (string, string, error) {
+	policyID, deploymentID, ok := strings.Cut(id, "/")
+	if !ok || !strings.HasPrefix(policyID, "apl-") || !strings.HasPrefix(deploymentID, "dep-") {
+		return "", "", fmt.Errorf("invalid deployment attachment ID '%s', expected '<access_policy_id>/<deployment_id>'", id)
+	}
+	return policyID, deploymentID, nil
+}
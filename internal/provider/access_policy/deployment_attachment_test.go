@@ -0,0 +1,103 @@
+package access_policy
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseDeploymentAttachmentID(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		wantPolicyID   string
+		wantDeployment string
+		wantErr        bool
+	}{
+		{
+			name:           "valid",
+			id:             "apl-123/dep-456",
+			wantPolicyID:   "apl-123",
+			wantDeployment: "dep-456",
+		},
+		{name: "missing separator", id: "apl-123", wantErr: true},
+		{name: "swapped", id: "dep-456/apl-123", wantErr: true},
+		{name: "empty deployment", id: "apl-123/", wantErr: true},
+		{name: "extra segment", id: "apl-123/dep-456/x", wantPolicyID: "apl-123", wantDeployment: "dep-456/x"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policyID, deploymentID, err := parseDeploymentAttachmentID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q, got none", tc.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if policyID != tc.wantPolicyID {
+				t.Errorf("expected policy ID %q, got %q", tc.wantPolicyID, policyID)
+			}
+			if deploymentID != tc.wantDeployment {
+				t.Errorf("expected deployment ID %q, got %q", tc.wantDeployment, deploymentID)
+			}
+			if got := deploymentAttachmentID(policyID, deploymentID); got != tc.id {
+				t.Errorf("expected round trip to %q, got %q", tc.id, got)
+			}
+		})
+	}
+}
+
+func TestWithoutDeployment(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		want []string
+	}{
+		{name: "present", ids: []string{"dep-a", "dep-b", "dep-c"}, want: []string{"dep-a", "dep-c"}},
+		{name: "absent", ids: []string{"dep-a", "dep-c"}, want: []string{"dep-a", "dep-c"}},
+		{name: "duplicated", ids: []string{"dep-b", "dep-a", "dep-b"}, want: []string{"dep-a"}},
+		{name: "empty", ids: nil, want: []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			original := slices.Clone(tc.ids)
+			got := withoutDeployment(tc.ids, "dep-b")
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if !slices.Equal(tc.ids, original) {
+				t.Errorf("expected input to be left unchanged, got %v", tc.ids)
+			}
+		})
+	}
+}
+
+func TestSameDeployments(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want bool
+	}{
+		{name: "equal", a: []string{"dep-a", "dep-b"}, b: []string{"dep-a", "dep-b"}, want: true},
+		{name: "reordered", a: []string{"dep-b", "dep-a"}, b: []string{"dep-a", "dep-b"}, want: true},
+		{name: "nil and empty", a: nil, b: []string{}, want: true},
+		{name: "extra", a: []string{"dep-a", "dep-b", "dep-c"}, b: []string{"dep-a", "dep-b"}, want: false},
+		{name: "replaced", a: []string{"dep-a", "dep-c"}, b: []string{"dep-a", "dep-b"}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			original := slices.Clone(tc.a)
+			if got := sameDeployments(tc.a, tc.b); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if !slices.Equal(tc.a, original) {
+				t.Errorf("expected input to be left unchanged, got %v", tc.a)
+			}
+		})
+	}
+}
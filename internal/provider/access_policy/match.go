@@ -0,0 +1,305 @@
+package access_policy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	matchDataSourceDescription = "Use this data source to find the access policies that would deliver to a workload now: those enabled and, at the time the data source is read, inside their `not_before`/`expires_at` window and `schedule`. The workload's attributes are evaluated against each policy's attestation criteria, all of which must match, and the matching policies are returned with their resolved delivery items. Useful for debugging a workload that did not receive a credential, and in `terraform test` assertions."
+
+	matchDeploymentIDDesc   = "The ID of the deployment the workload runs in. Only policies applying to this deployment are evaluated"
+	matchNamespaceDesc      = "The Kubernetes namespace of the workload, matched by k8s:ns criteria"
+	matchServiceAccountDesc = "The Kubernetes service account of the workload, matched by k8s:sa criteria"
+	matchPodLabelsDesc      = "The pod labels of the workload, matched by k8s:pod-label criteria"
+	matchPodNameDesc        = "The pod name of the workload, matched by k8s:pod-name criteria"
+	matchContainerDesc      = "The container name of the workload, matched by k8s:container-name criteria"
+	matchPoliciesDesc       = "The access policies in force when the data source is read, that is enabled, inside their not_before/expires_at window and schedule, whose attestation criteria all match the workload"
+
+	deliveryItemDesc        = "The resolved delivery items of the policy, one per environment variable, file or SDK item, and one per workload identity federation config"
+	deliveryItemTypeDesc    = "The delivery type (env, volume, sdk, aws_wif, gcp_wif, azure_wif)"
+	deliveryItemTargetDesc  = "Where the item is delivered: the environment variable name, the absolute file path, the SDK secret and item name as secret_name/name, or for workload identity federation the role ARN, service account or client ID"
+	deliveryItemKeyDesc     = "The credential key or template string for the item. Empty for a whole-credential render_as file and for workload identity federation"
+	deliveryItemMappingDesc = "The type of delivery item mapping (key or template). Empty for a whole-credential render_as file and for workload identity federation"
+)
+
+// workload holds the attributes a workload presents for attestation.
+type workload struct {
+	namespace      string
+	serviceAccount string
+	podLabels      map[string]string
+	podName        string
+	container      string
+}
+
+func MatchDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: matchDataSourceDescription,
+		ReadContext: accessPolicyMatchRead,
+		Schema:      AccessPolicyMatchDataSourceSchema(),
+	}
+}
+
+func AccessPolicyMatchDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the deployment evaluated",
+		},
+		"deployment_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  matchDeploymentIDDesc,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: matchNamespaceDesc,
+		},
+		"service_account": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: matchServiceAccountDesc,
+		},
+		"pod_labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: matchPodLabelsDesc,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"pod_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: matchPodNameDesc,
+		},
+		"container": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: matchContainerDesc,
+		},
+		"policies": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: matchPoliciesDesc,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: idDesc,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: nameDesc,
+					},
+					"access_credential_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: accessCredentialIDDesc,
+					},
+					"access_privilege_ids": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: accessPrivilegeIDsDesc,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"delivery_item": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: deliveryItemDesc,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"delivery_type": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: deliveryItemTypeDesc,
+								},
+								"target": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: deliveryItemTargetDesc,
+								},
+								"key": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: deliveryItemKeyDesc,
+								},
+								"type": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: deliveryItemMappingDesc,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessPolicyMatchRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	deploymentID := d.Get("deployment_id").(string)
+	w := workload{
+		namespace:      d.Get("namespace").(string),
+		serviceAccount: d.Get("service_account").(string),
+		podLabels:      make(map[string]string),
+		podName:        d.Get("pod_name").(string),
+		container:      d.Get("container").(string),
+	}
+	for k, v := range d.Get("pod_labels").(map[string]any) {
+		w.podLabels[k] = v.(string)
+	}
+
+	policies, err := client.GetAccessPoliciesByDeployment(ctx, c, deploymentID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list access policies for deployment '%s': %w", deploymentID, err))
+	}
+
+	now := time.Now()
+	matched := make([]any, 0)
+	for i := range policies {
+		policy := &policies[i]
+		if !inForce(policy, deploymentID, now) {
+			continue
+		}
+		if !matchesAttestationCriteria(policy.AttestationCriteria, w) {
+			continue
+		}
+		matched = append(matched, map[string]any{
+			"id":                   policy.ID,
+			"name":                 policy.Name,
+			"access_credential_id": policy.AccessCredentialID,
+			"access_privilege_ids": policy.AccessPrivilegeIDs,
+			"delivery_item":        resolveDeliveryItems(deliveryConfigsOf(policy)),
+		})
+	}
+
+	d.SetId(deploymentID)
+
+	if err := d.Set("policies", matched); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set policies: %w", err))
+	}
+
+	return nil
+}
+
+// inForce reports whether policy delivers to deploymentID at now: it must be
+// effectively enabled, which also accounts for its expiry, not_before and
+// schedule, and apply to the deployment.
+func inForce(policy *client.AccessPolicy, deploymentID string, now time.Time) bool {
+	return effectiveEnabled(policy, now) && appliesToDeployment(policy, deploymentID)
+}
+
+// appliesToDeployment guards against a list filter the backend does not
+// honour; a policy for another deployment must never be reported as a match.
+func appliesToDeployment(policy *client.AccessPolicy, deploymentID string) bool {
+	for _, id := range policy.DeploymentIDs {
+		if id == deploymentID {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAttestationCriteria reports whether the workload satisfies every
+// criterion. Values are compared exactly; an attribute the workload does not
+// present satisfies no criterion on it.
+func matchesAttestationCriteria(criteria []client.AttestationCriterion, w workload) bool {
+	for _, criterion := range criteria {
+		var ok bool
+		switch criterion.Type {
+		case client.AttestationCriterionTypeK8sNamespace:
+			ok = w.namespace != "" && w.namespace == criterion.Value
+		case client.AttestationCriterionTypeK8sServiceAccount:
+			ok = w.serviceAccount != "" && w.serviceAccount == criterion.Value
+		case client.AttestationCriterionTypeK8sPodLabel:
+			v, present := w.podLabels[criterion.Key]
+			ok = present && v == criterion.Value
+		case client.AttestationCriterionTypeK8sPodName:
+			ok = w.podName != "" && w.podName == criterion.Value
+		case client.AttestationCriterionTypeK8sContainerName:
+			ok = w.container != "" && w.container == criterion.Value
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveDeliveryItems flattens a policy's delivery configs into one entry per
+// delivered item, in the order the API returns them.
+func resolveDeliveryItems(configs []any) []any {
+	items := make([]any, 0)
+	item := func(deliveryType client.DeliveryType, target string, fields map[string]any) map[string]any {
+		key, _ := fields["key"].(string)
+		mapping, _ := fields["type"].(string)
+		return map[string]any{
+			"delivery_type": string(deliveryType),
+			"target":        target,
+			"key":           key,
+			"type":          mapping,
+		}
+	}
+
+	for _, config := range configs {
+		configMap, ok := config.(map[string]any)
+		if !ok {
+			continue
+		}
+		deliveryType, _ := configMap["type"].(string)
+		rawItems, _ := configMap["items"].([]any)
+
+		switch client.DeliveryType(deliveryType) {
+		case client.DeliveryTypeEnv:
+			for _, raw := range rawItems {
+				fields, _ := raw.(map[string]any)
+				name, _ := fields["name"].(string)
+				items = append(items, item(client.DeliveryTypeEnv, name, fields))
+			}
+		case client.DeliveryTypeVolume:
+			mountPoint, _ := configMap["mount_point"].(string)
+			for _, raw := range rawItems {
+				fields, _ := raw.(map[string]any)
+				p, _ := fields["path"].(string)
+				items = append(items, item(client.DeliveryTypeVolume, path.Join(mountPoint, p), fields))
+			}
+			if renderAs, ok := configMap["render_as"].(map[string]any); ok {
+				p, _ := renderAs["path"].(string)
+				items = append(items, item(client.DeliveryTypeVolume, path.Join(mountPoint, p), nil))
+			}
+		case client.DeliveryTypeSdk:
+			secretName, _ := configMap["secret_name"].(string)
+			for _, raw := range rawItems {
+				fields, _ := raw.(map[string]any)
+				name, _ := fields["name"].(string)
+				items = append(items, item(client.DeliveryTypeSdk, strings.TrimSuffix(secretName, "/")+"/"+name, fields))
+			}
+		case client.DeliveryTypeAwsWif:
+			roleArn, _ := configMap["role_arn"].(string)
+			items = append(items, item(client.DeliveryTypeAwsWif, roleArn, nil))
+		case client.DeliveryTypeGcpWif:
+			sa, _ := configMap["service_account"].(string)
+			items = append(items, item(client.DeliveryTypeGcpWif, sa, nil))
+		case client.DeliveryTypeAzureWif:
+			clientID, _ := configMap["client_id"].(string)
+			items = append(items, item(client.DeliveryTypeAzureWif, clientID, nil))
+		}
+	}
+	return items
+}
@@ -0,0 +1,144 @@
+package access_policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestMatchesAttestationCriteria(t *testing.T) {
+	w := workload{
+		namespace:      "production",
+		serviceAccount: "api",
+		podLabels:      map[string]string{"app": "api", "tier": "backend"},
+		podName:        "api-7d9f",
+		container:      "server",
+	}
+
+	cases := []struct {
+		name     string
+		criteria []client.AttestationCriterion
+		want     bool
+	}{
+		{"namespace", []client.AttestationCriterion{
+			{Type: client.AttestationCriterionTypeK8sNamespace, Value: "production"},
+		}, true},
+		{"every criterion must match", []client.AttestationCriterion{
+			{Type: client.AttestationCriterionTypeK8sNamespace, Value: "production"},
+			{Type: client.AttestationCriterionTypeK8sServiceAccount, Value: "worker"},
+		}, false},
+		{"pod label", []client.AttestationCriterion{
+			{Type: client.AttestationCriterionTypeK8sPodLabel, Key: "tier", Value: "backend"},
+		}, true},
+		{"pod label absent", []client.AttestationCriterion{
+			{Type: client.AttestationCriterionTypeK8sPodLabel, Key: "team", Value: ""},
+		}, false},
+		{"pod name and container", []client.AttestationCriterion{
+			{Type: client.AttestationCriterionTypeK8sPodName, Value: "api-7d9f"},
+			{Type: client.AttestationCriterionTypeK8sContainerName, Value: "server"},
+		}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesAttestationCriteria(tc.criteria, w); got != tc.want {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	// An attribute the workload does not present satisfies no criterion on it.
+	if matchesAttestationCriteria([]client.AttestationCriterion{
+		{Type: client.AttestationCriterionTypeK8sServiceAccount, Value: ""},
+	}, workload{}) {
+		t.Error("expected a missing service account not to match")
+	}
+}
+
+func TestInForce(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	disabled := false
+
+	cases := []struct {
+		name   string
+		policy client.AccessPolicy
+		want   bool
+	}{
+		{"enabled", client.AccessPolicy{Enabled: true, DeploymentIDs: []string{"dep-prod"}}, true},
+		{"disabled", client.AccessPolicy{DeploymentIDs: []string{"dep-prod"}}, false},
+		{"other deployment", client.AccessPolicy{Enabled: true, DeploymentIDs: []string{"dep-dev"}}, false},
+		{"expired", client.AccessPolicy{
+			Enabled: true, DeploymentIDs: []string{"dep-prod"}, ExpiresAt: "2026-03-01T00:00:00Z",
+		}, false},
+		{"not yet valid", client.AccessPolicy{
+			Enabled: true, DeploymentIDs: []string{"dep-prod"}, NotBefore: "2026-04-01T00:00:00Z",
+		}, false},
+		{"outside schedule", client.AccessPolicy{
+			Enabled: true, DeploymentIDs: []string{"dep-prod"},
+			Schedule: &client.AccessPolicySchedule{Cron: "* 9-11 * * *", Timezone: "UTC"},
+		}, false},
+		{"reported out of force by the api", client.AccessPolicy{
+			Enabled: true, DeploymentIDs: []string{"dep-prod"}, EffectiveEnabled: &disabled,
+		}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := inForce(&tc.policy, "dep-prod", now); got != tc.want {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveDeliveryItems(t *testing.T) {
+	configs := []any{
+		map[string]any{
+			"type": "env",
+			"items": []any{
+				map[string]any{"name": "DB_PASSWORD", "key": "password", "type": "key"},
+			},
+		},
+		map[string]any{
+			"type":        "volume",
+			"mount_point": "/etc/secrets",
+			"items": []any{
+				map[string]any{"path": "db/url", "key": "${host}", "type": "template"},
+			},
+		},
+		map[string]any{
+			"type":        "sdk",
+			"secret_name": "prod/db",
+			"items": []any{
+				map[string]any{"name": "username", "key": "username", "type": "key"},
+			},
+		},
+		map[string]any{
+			"type":     "aws_wif",
+			"role_arn": "arn:aws:iam::123456789012:role/app",
+		},
+	}
+
+	result := resolveDeliveryItems(configs)
+
+	if len(result) != 4 {
+		t.Fatalf("expected 4 items, got %d", len(result))
+	}
+	want := []struct{ deliveryType, target, key string }{
+		{"env", "DB_PASSWORD", "password"},
+		{"volume", "/etc/secrets/db/url", "${host}"},
+		{"sdk", "prod/db/username", "username"},
+		{"aws_wif", "arn:aws:iam::123456789012:role/app", ""},
+	}
+	for i, w := range want {
+		item := result[i].(map[string]any)
+		if item["delivery_type"] != w.deliveryType {
+			t.Errorf("expected item[%d].delivery_type %q, got %q", i, w.deliveryType, item["delivery_type"])
+		}
+		if item["target"] != w.target {
+			t.Errorf("expected item[%d].target %q, got %q", i, w.target, item["target"])
+		}
+		if item["key"] != w.key {
+			t.Errorf("expected item[%d].key %q, got %q", i, w.key, item["key"])
+		}
+	}
+}
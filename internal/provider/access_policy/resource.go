@@ -2,13 +2,16 @@ package access_policy
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
-const resourceDescription = "Access policy resource for managing Hush Security access policies"
+const resourceDescription = "Access policy resource for managing Hush Security access policies. Delivery blocks of different types may be combined on one policy, for example env_delivery_config alongside volume_delivery_config, to deliver the same credential in several forms."
 
 func Resource() *schema.Resource {
 	return &schema.Resource{
@@ -22,10 +25,82 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: AccessPolicyResourceSchema(),
+		CustomizeDiff: accessPolicyCustomizeDiff,
+		Schema:        AccessPolicyResourceSchema(),
 	}
 }
 
+// accessPolicyCustomizeDiff refuses, while the caller can still change it, a
+// policy the API would reject at apply time or accept and then fail to deliver:
+// a template delivery item that would not render, a privilege for another type
+// of credential, or a deployment the credential is not available in. It also
+// refuses a required deployment the policy does not apply to, and an
+// expires_at that is not in the future or not after not_before.
+//
+// Template syntax, required deployments and the time window are always
+// checked. The rest takes lookups of the credential and privileges, so it runs
+// only on create or when the fields it reads change, and only for IDs known at
+// plan time. An ID created in the same apply is skipped, as is one the lookup
+// fails for: refusing a configuration that is very likely fine is worse than
+// leaving it to the API.
+func accessPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	now := time.Now()
+	if d.NewValueKnown("not_before") && d.NewValueKnown("expires_at") {
+		checkExpiry := d.Id() == "" || d.HasChange("expires_at")
+		if err := validateAccessWindow(d.Get("not_before").(string), d.Get("expires_at").(string), checkExpiry, now); err != nil {
+			return err
+		}
+	}
+	if err := planEffectiveEnabled(d, now); err != nil {
+		return err
+	}
+
+	if d.NewValueKnown("required_deployments") && d.NewValueKnown("deployment_ids") {
+		if err := checkRequiredDeployments(
+			expandStringList(d.Get("required_deployments").([]any)),
+			expandStringList(d.Get("deployment_ids").([]any)),
+		); err != nil {
+			return err
+		}
+	}
+
+	items := templateDeliveryItems(d)
+
+	c, ok := m.(*client.Client)
+	checkReferences := ok && (d.Id() == "" || d.HasChanges(append([]string{
+		"access_credential_id", "access_privilege_ids", "deployment_ids",
+	}, deliveryConfigAtLeastOneOf...)...))
+
+	var cred *client.AccessCredential
+	if checkReferences && d.NewValueKnown("access_credential_id") {
+		if id := d.Get("access_credential_id").(string); id != "" {
+			cred, _ = client.GetAccessCredential(ctx, c, id)
+		}
+	}
+
+	if cred != nil && d.NewValueKnown("access_privilege_ids") && d.NewValueKnown("deployment_ids") {
+		var privileges []*client.AccessPrivilege
+		for _, id := range expandStringList(d.Get("access_privilege_ids").([]any)) {
+			if id == "" {
+				continue
+			}
+			if privilege, err := client.GetAccessPrivilege(ctx, c, id); err == nil {
+				privileges = append(privileges, privilege)
+			}
+		}
+		deploymentIDs := expandStringList(d.Get("deployment_ids").([]any))
+		if err := checkCredentialCompatibility(cred, privileges, deploymentIDs); err != nil {
+			return err
+		}
+	}
+
+	var keys []string
+	if cred != nil && len(cred.Keys) > 0 {
+		keys = cred.Keys
+	}
+	return validateTemplateDeliveryItems(items, keys)
+}
+
 func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*client.Client)
 
@@ -35,7 +110,10 @@ func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, met
 		AccessCredentialID:  d.Get("access_credential_id").(string),
 		DeploymentIDs:       expandStringList(d.Get("deployment_ids").([]any)),
 		AttestationCriteria: expandAttestationCriteria(d.Get("attestation_criteria").([]any)),
-		DeliveryConfig:      expandDeliveryConfig(d),
+		DeliveryConfigs:     expandDeliveryConfig(d),
+		NotBefore:           d.Get("not_before").(string),
+		ExpiresAt:           d.Get("expires_at").(string),
+		Schedule:            expandSchedule(d.Get("schedule").([]any)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -46,7 +124,8 @@ func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, met
 		input.AccessPrivilegeIDs = expandStringList(v.([]any))
 	}
 
-	policy, err := client.CreateAccessPolicy(ctx, c, input)
+	requiredDeployments := expandStringList(d.Get("required_deployments").([]any))
+	policy, err := client.CreateAccessPolicy(ctx, c, input, requiredDeployments)
 	if policy != nil {
 		d.SetId(policy.ID)
 	}
@@ -54,7 +133,7 @@ func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	return accessPolicyRead(ctx, d, meta)
+	return warnRequiredDeploymentsUnchecked(d, accessPolicyRead(ctx, d, meta))
 }
 
 func resourceAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -97,19 +176,66 @@ func resourceAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.AttestationCriteria = &criteria
 	}
 
-	if d.HasChanges(deliveryConfigExactlyOneOf...) {
-		input.DeliveryConfig = expandDeliveryConfig(d)
+	if d.HasChange("not_before") {
+		input.NotBefore = client.NewNullableString(d.Get("not_before").(string))
+	}
+
+	if d.HasChange("expires_at") {
+		input.ExpiresAt = client.NewNullableString(d.Get("expires_at").(string))
+	}
+
+	if d.HasChange("schedule") {
+		input.Schedule = client.NewScheduleUpdate(expandSchedule(d.Get("schedule").([]any)))
+	}
+
+	// Write the list and clear the singular field in the same request, so a
+	// policy that still holds the singular is migrated rather than refused for
+	// holding both.
+	if d.HasChanges(deliveryConfigAtLeastOneOf...) {
+		input.DeliveryConfigs = expandDeliveryConfig(d)
+		input.DeliveryConfig = client.NewDeliveryConfigUpdate(nil)
+	}
+
+	// required_deployments only shapes the wait and is never sent, and
+	// effective_enabled changes only when an expiry passes, see
+	// planEffectiveEnabled.
+	if !d.HasChangesExcept("required_deployments", "effective_enabled") {
+		return accessPolicyRead(ctx, d, meta)
 	}
 
-	_, err := client.UpdateAccessPolicy(ctx, c, d.Id(), input)
+	requiredDeployments := expandStringList(d.Get("required_deployments").([]any))
+	_, err := client.UpdateAccessPolicy(ctx, c, d.Id(), input, requiredDeployments)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return accessPolicyRead(ctx, d, meta)
+	return warnRequiredDeploymentsUnchecked(d, accessPolicyRead(ctx, d, meta))
+}
+
+// warnRequiredDeploymentsUnchecked warns when required_deployments is set but
+// the API reported no per-deployment status. The wait then fell back to the
+// policy's overall status, which every deployment in deployment_ids feeds, so
+// required_deployments had no effect.
+func warnRequiredDeploymentsUnchecked(d *schema.ResourceData, diags diag.Diagnostics) diag.Diagnostics {
+	if diags.HasError() || len(d.Get("required_deployments").([]any)) == 0 {
+		return diags
+	}
+	if len(d.Get("deployment_status").([]any)) > 0 {
+		return diags
+	}
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "required_deployments was not applied",
+		Detail: fmt.Sprintf("The API reported no per-deployment status for access policy '%s', so the apply waited on "+
+			"its overall status, to which every deployment in deployment_ids contributes.", d.Id()),
+	})
 }
 
 func resourceAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access policy"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 
 	err := client.DeleteAccessPolicy(ctx, c, d.Id())
@@ -120,3 +246,20 @@ func resourceAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return nil
 }
+
+// planEffectiveEnabled marks effective_enabled for recomputation when a field it
+// depends on changes. Otherwise, when the API does not enforce expiry and the
+// policy has expired since it was last read as in force, it plans the change to
+// false, so the expiry shows in the plan as drift rather than going unnoticed.
+func planEffectiveEnabled(d *schema.ResourceDiff, now time.Time) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChanges("enabled", "not_before", "expires_at", "schedule") {
+		return d.SetNewComputed("effective_enabled")
+	}
+	if d.Get("effective_enabled").(bool) && hasExpired(d.Get("expires_at").(string), now) {
+		return d.SetNew("effective_enabled", false)
+	}
+	return nil
+}
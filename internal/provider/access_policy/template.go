@@ -0,0 +1,78 @@
+package access_policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deliverytemplate"
+)
+
+// attributeGetter is the subset of *schema.ResourceDiff that
+// templateDeliveryItems needs; *schema.ResourceData satisfies it as well, which
+// keeps the logic unit-testable.
+type attributeGetter interface {
+	Get(string) any
+}
+
+// templateDeliveryItem is a template-type delivery item together with the
+// attribute path it came from, so an error can point at the offending block.
+type templateDeliveryItem struct {
+	path     string
+	template string
+}
+
+// templateDeliveryItems collects every template-type item from the env, volume
+// and sdk delivery blocks.
+func templateDeliveryItems(d attributeGetter) []templateDeliveryItem {
+	var out []templateDeliveryItem
+	collect := func(prefix string, raw any) {
+		items, _ := raw.([]any)
+		for i, item := range items {
+			fields, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if t, _ := fields["type"].(string); t != string(client.DeliveryMappingTypeTemplate) {
+				continue
+			}
+			key, _ := fields["key"].(string)
+			out = append(out, templateDeliveryItem{
+				path:     fmt.Sprintf("%s.%d.key", prefix, i),
+				template: key,
+			})
+		}
+	}
+
+	collect("env_delivery_config", d.Get("env_delivery_config"))
+	collect("volume_delivery_config.0.item", d.Get("volume_delivery_config.0.item"))
+	collect("sdk_delivery_config.0.items", d.Get("sdk_delivery_config.0.items"))
+	return out
+}
+
+// validateTemplateDeliveryItems parses each template and, when keys is not
+// nil, checks that every key it references is one the credential exposes.
+func validateTemplateDeliveryItems(items []templateDeliveryItem, keys []string) error {
+	for _, item := range items {
+		tmpl, err := deliverytemplate.Parse(item.template)
+		if err != nil {
+			return fmt.Errorf("%s: %w", item.path, err)
+		}
+		if keys == nil {
+			continue
+		}
+		if missing := tmpl.MissingKeys(keys); len(missing) > 0 {
+			return fmt.Errorf("%s: template references %s not exposed by the access credential (available keys: %s)",
+				item.path, quoteKeys(missing), strings.Join(keys, ", "))
+		}
+	}
+	return nil
+}
+
+func quoteKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = fmt.Sprintf("%q", k)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,69 @@
+package access_policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTemplateDeliveryItems(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, AccessPolicyResourceSchema(), map[string]any{
+		"env_delivery_config": []any{
+			map[string]any{"name": "PORT", "key": "port", "type": "key"},
+			map[string]any{"name": "DATABASE_URL", "key": "postgresql://${username}@${host}/db", "type": "template"},
+		},
+		"volume_delivery_config": []any{
+			map[string]any{
+				"mount_point": "/etc/secrets",
+				"item": []any{
+					map[string]any{"path": "dsn", "key": "${username}:${password}", "type": "template"},
+				},
+			},
+		},
+	})
+
+	items := templateDeliveryItems(d)
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 template items, got %d", len(items))
+	}
+	if items[0].path != "env_delivery_config.1.key" {
+		t.Errorf("expected items[0].path %q, got %q", "env_delivery_config.1.key", items[0].path)
+	}
+	if items[1].path != "volume_delivery_config.0.item.0.key" {
+		t.Errorf("expected items[1].path %q, got %q", "volume_delivery_config.0.item.0.key", items[1].path)
+	}
+}
+
+func TestValidateTemplateDeliveryItems(t *testing.T) {
+	keys := []string{"username", "password", "host", "port", "db"}
+
+	cases := []struct {
+		name    string
+		item    string
+		keys    []string
+		wantErr string
+	}{
+		{"known keys", "postgresql://${username}:${password}@${host}:${port}/${db}", keys, ""},
+		{"typo in key", "postgresql://${usernme}@${host}", keys, `"usernme" not exposed`},
+		{"syntax error", "postgresql://${username", keys, "unterminated placeholder"},
+		// Without the credential's keys only syntax can be checked.
+		{"keys unknown", "${anything}", nil, ""},
+		{"syntax error with keys unknown", "${}", nil, "empty placeholder"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTemplateDeliveryItems([]templateDeliveryItem{{path: "env_delivery_config.0.key", template: tc.item}}, tc.keys)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package anthropic_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+const (
+	idDesc               = "The unique identifier of the Anthropic access credential"
+	nameDesc             = "The name of the Anthropic access credential"
+	descriptionDesc      = "The description of the Anthropic access credential"
+	deploymentIDsDesc    = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	adminAPIKeyDesc      = "The Anthropic Admin API key (`sk-ant-admin...`) Hush uses to mint and revoke API keys"
+	adminAPIKeyWODesc    = "The Anthropic Admin API key (write-only). This is a write-only attribute that is more secure than `admin_api_key` because Terraform will not store this value in the state file. Either `admin_api_key` or `admin_api_key_wo` must be specified."
+	adminAPIKeyWOVerDesc = "Used to trigger updates for `admin_api_key_wo`. This value should be changed when the Admin API key content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	organizationDesc     = "The Anthropic organization ID the Admin API key belongs to (optional)"
+	workspaceIDDesc      = "The Anthropic workspace minted API keys are created in (must start with 'wrkspc_'). Privileges can override it; when neither sets one, keys are created in the organization's default workspace"
+	typeDesc             = "The type of access credential"
+	kindDesc             = "The kind of access credential"
+	secretStoreIDDesc    = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["admin_api_key"] = &schema.Schema{
+		Description:   adminAPIKeyDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"admin_api_key_wo"},
+		ExactlyOneOf:  []string{"admin_api_key", "admin_api_key_wo"},
+	}
+	s["admin_api_key_wo"] = &schema.Schema{
+		Description:   adminAPIKeyWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"admin_api_key"},
+		ExactlyOneOf:  []string{"admin_api_key", "admin_api_key_wo"},
+		RequiredWith:  []string{"admin_api_key_wo_version"},
+	}
+	s["admin_api_key_wo_version"] = &schema.Schema{
+		Description:  adminAPIKeyWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"admin_api_key_wo"},
+	}
+	s["organization_id"] = &schema.Schema{
+		Description:  organizationDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	s["workspace_id"] = &schema.Schema{
+		Description:  workspaceIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^wrkspc_`), "workspace_id must start with 'wrkspc_'"),
+	}
+
+	s["rotation"] = credutil.RotationResourceSchema()
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"organization_id": {
+			Description: organizationDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"workspace_id": {
+			Description: workspaceIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"rotation":           credutil.RotationDataSourceSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
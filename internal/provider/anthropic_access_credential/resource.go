@@ -0,0 +1,177 @@
+package anthropic_access_credential
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+func Resource() *schema.Resource {
+	return credutil.WithSecretDrift(&schema.Resource{
+		Description:   "Manage Anthropic dynamic access credentials in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: credutil.ForbidDeploymentIDsChange,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	})
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	deploymentIDs := make([]string, 0)
+	if v, ok := d.GetOk("deployment_ids"); ok {
+		for _, item := range v.([]any) {
+			deploymentIDs = append(deploymentIDs, item.(string))
+		}
+	}
+
+	adminAPIKey := writeonly.GetString(d, "admin_api_key", "admin_api_key_wo")
+
+	input := &client.CreateAnthropicAccessCredentialInput{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		DeploymentIDs:  deploymentIDs,
+		SecretStoreID:  d.Get("secret_store_id").(string),
+		AdminAPIKey:    adminAPIKey,
+		OrganizationID: d.Get("organization_id").(string),
+		WorkspaceID:    d.Get("workspace_id").(string),
+		Rotation:       credutil.ExpandRotation(d.Get("rotation").([]any)),
+	}
+
+	credential, err := client.CreateAnthropicAccessCredential(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	credential, err := client.GetAnthropicAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	fields := map[string]any{
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"organization_id":    credential.OrganizationID,
+		"workspace_id":       credential.WorkspaceID,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
+		"rotation":           credutil.FlattenRotation(credential.Rotation, d),
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateAnthropicAccessCredentialInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("secret_store_id") {
+		v := d.Get("secret_store_id").(string)
+		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
+	}
+	if d.HasChange("organization_id") {
+		input.OrganizationID = client.NewNullableString(d.Get("organization_id").(string))
+	}
+	if d.HasChange("workspace_id") {
+		input.WorkspaceID = client.NewNullableString(d.Get("workspace_id").(string))
+	}
+	if d.HasChange("admin_api_key") || d.HasChange("admin_api_key_wo") || d.HasChange("admin_api_key_wo_version") || credutil.ResendSecret(d, "admin_api_key", "admin_api_key_wo") {
+		adminAPIKey := writeonly.GetString(d, "admin_api_key", "admin_api_key_wo")
+		input.AdminAPIKey = &adminAPIKey
+	}
+
+	if d.HasChange("rotation") {
+		input.Rotation = client.NewRotationUpdate(credutil.ExpandRotation(d.Get("rotation").([]any)))
+	}
+
+	_, err := client.UpdateAnthropicAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.RotateIfRequested(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package anthropic_access_privilege
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandPermissions(t *testing.T) {
+	input := []any{
+		map[string]any{"name": "messages", "level": "write"},
+		map[string]any{"name": "models", "level": "read"},
+	}
+
+	result := expandPermissions(input)
+
+	expected := []client.AnthropicPermission{
+		{Name: "messages", Level: "write"},
+		{Name: "models", Level: "read"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestRateLimitsRoundTrip(t *testing.T) {
+	original := []client.AnthropicRateLimit{
+		{RequestsPerMinute: 50},
+		{Model: "claude-sonnet-4-5", InputTokensPerMinute: 40000, OutputTokensPerMinute: 8000},
+	}
+
+	result := expandRateLimits(flattenRateLimits(original))
+
+	if !reflect.DeepEqual(result, original) {
+		t.Errorf("expected %+v, got %+v", original, result)
+	}
+
+	m := flattenRateLimits(original)[0].(map[string]any)
+	if m["model"] != "" {
+		t.Errorf("expected empty model for the all-models limit, got '%v'", m["model"])
+	}
+}
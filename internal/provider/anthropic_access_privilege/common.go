@@ -0,0 +1,268 @@
+package anthropic_access_privilege
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	idDesc             = "The unique identifier of the Anthropic access privilege"
+	nameDesc           = "The name of the Anthropic access privilege"
+	descriptionDesc    = "The description of the Anthropic access privilege"
+	permissionTypeDesc = "The permission type of the minted API keys (Admin, Developer, User, or Restricted)"
+	permissionsDesc    = "The list of specific permissions (required when permission_type is Restricted, not allowed otherwise)"
+	permNameDesc       = "The API the permission applies to (e.g., messages, models, files, message_batches)"
+	permLevelDesc      = "The level of the permission (read or write)"
+	workspaceIDDesc    = "The Anthropic workspace minted API keys are scoped to (must start with 'wrkspc_'). Overrides the credential's workspace_id"
+	spendLimitDesc     = "The monthly spend limit of the workspace minted API keys are scoped to, in whole US dollars"
+	rateLimitsDesc     = "Rate limits applied to each minted API key, for one model or for every model"
+	modelDesc          = "The model the rate limit applies to (e.g., claude-sonnet-4-5). Omit to apply it to every model"
+	requestsPerMinDesc = "The maximum number of requests per minute"
+	inputTPMDesc       = "The maximum number of input tokens per minute"
+	outputTPMDesc      = "The maximum number of output tokens per minute"
+	typeDesc           = "The type of access privilege"
+)
+
+const permissionTypeRestricted = "Restricted"
+
+var (
+	validPermissionTypes  = []string{"Admin", "Developer", "User", permissionTypeRestricted}
+	validPermissionLevels = []string{"read", "write"}
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["permission_type"] = &schema.Schema{
+		Description:  permissionTypeDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(validPermissionTypes, false),
+	}
+	s["permissions"] = &schema.Schema{
+		Description: permissionsDesc,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  permNameDesc,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"level": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  permLevelDesc,
+					ValidateFunc: validation.StringInSlice(validPermissionLevels, false),
+				},
+			},
+		},
+	}
+	s["workspace_id"] = &schema.Schema{
+		Description:  workspaceIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^wrkspc_`), "workspace_id must start with 'wrkspc_'"),
+	}
+	s["monthly_spend_limit_usd"] = &schema.Schema{
+		Description:  spendLimitDesc,
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	s["rate_limits"] = &schema.Schema{
+		Description: rateLimitsDesc,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"model": {
+					Description: modelDesc,
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"requests_per_minute": {
+					Description:  requestsPerMinDesc,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"input_tokens_per_minute": {
+					Description:  inputTPMDesc,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"output_tokens_per_minute": {
+					Description:  outputTPMDesc,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"permission_type": {
+			Description: permissionTypeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"permissions": {
+			Description: permissionsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: permNameDesc,
+					},
+					"level": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: permLevelDesc,
+					},
+				},
+			},
+		},
+		"workspace_id": {
+			Description: workspaceIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"monthly_spend_limit_usd": {
+			Description: spendLimitDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"rate_limits": {
+			Description: rateLimitsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Description: modelDesc,
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"requests_per_minute": {
+						Description: requestsPerMinDesc,
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"input_tokens_per_minute": {
+						Description: inputTPMDesc,
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"output_tokens_per_minute": {
+						Description: outputTPMDesc,
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func expandPermissions(list []any) []client.AnthropicPermission {
+	permissions := make([]client.AnthropicPermission, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		permissions[i] = client.AnthropicPermission{
+			Name:  m["name"].(string),
+			Level: m["level"].(string),
+		}
+	}
+	return permissions
+}
+
+func flattenPermissions(permissions []client.AnthropicPermission) []any {
+	result := make([]any, len(permissions))
+	for i, p := range permissions {
+		m := map[string]any{
+			"name":  p.Name,
+			"level": p.Level,
+		}
+		result[i] = m
+	}
+	return result
+}
+
+func expandRateLimits(list []any) []client.AnthropicRateLimit {
+	limits := make([]client.AnthropicRateLimit, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		limits[i] = client.AnthropicRateLimit{
+			Model:                 m["model"].(string),
+			RequestsPerMinute:     m["requests_per_minute"].(int),
+			InputTokensPerMinute:  m["input_tokens_per_minute"].(int),
+			OutputTokensPerMinute: m["output_tokens_per_minute"].(int),
+		}
+	}
+	return limits
+}
+
+func flattenRateLimits(limits []client.AnthropicRateLimit) []any {
+	result := make([]any, len(limits))
+	for i, l := range limits {
+		result[i] = map[string]any{
+			"model":                    l.Model,
+			"requests_per_minute":      l.RequestsPerMinute,
+			"input_tokens_per_minute":  l.InputTokensPerMinute,
+			"output_tokens_per_minute": l.OutputTokensPerMinute,
+		}
+	}
+	return result
+}
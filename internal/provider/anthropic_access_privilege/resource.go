@@ -0,0 +1,174 @@
+package anthropic_access_privilege
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage Anthropic access privileges in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: validatePermissions,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	}
+}
+
+// validatePermissions requires permissions with the Restricted permission
+// type and rejects them with the others, whose scope is fixed by the role.
+func validatePermissions(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("permission_type") || !d.NewValueKnown("permissions") {
+		return nil
+	}
+	permissionType := d.Get("permission_type").(string)
+	count := len(d.Get("permissions").([]any))
+	if permissionType == permissionTypeRestricted && count == 0 {
+		return fmt.Errorf("permission_type %q requires permissions", permissionTypeRestricted)
+	}
+	if permissionType != permissionTypeRestricted && count > 0 {
+		return fmt.Errorf("permissions are only allowed with permission_type %q", permissionTypeRestricted)
+	}
+	return nil
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	input := &client.CreateAnthropicAccessPrivilegeInput{
+		Name:                 d.Get("name").(string),
+		PermissionType:       d.Get("permission_type").(string),
+		WorkspaceID:          d.Get("workspace_id").(string),
+		MonthlySpendLimitUSD: d.Get("monthly_spend_limit_usd").(int),
+		RateLimits:           expandRateLimits(d.Get("rate_limits").([]any)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("permissions"); ok {
+		input.Permissions = expandPermissions(v.([]any))
+	}
+
+	privilege, err := client.CreateAnthropicAccessPrivilege(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	privilege, err := client.GetAnthropicAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	fields := map[string]any{
+		"name":                    privilege.Name,
+		"description":             privilege.Description,
+		"permission_type":         privilege.PermissionType,
+		"permissions":             flattenPermissions(privilege.Permissions),
+		"workspace_id":            privilege.WorkspaceID,
+		"monthly_spend_limit_usd": privilege.MonthlySpendLimitUSD,
+		"rate_limits":             flattenRateLimits(privilege.RateLimits),
+		"type":                    privilege.Type,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateAnthropicAccessPrivilegeInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("permission_type") {
+		v := d.Get("permission_type").(string)
+		input.PermissionType = &v
+	}
+	if d.HasChange("permissions") {
+		permissions := expandPermissions(d.Get("permissions").([]any))
+		input.Permissions = &permissions
+	}
+	if d.HasChange("workspace_id") {
+		input.WorkspaceID = client.NewNullableString(d.Get("workspace_id").(string))
+	}
+	if d.HasChange("monthly_spend_limit_usd") {
+		v := d.Get("monthly_spend_limit_usd").(int)
+		input.MonthlySpendLimitUSD = &v
+	}
+	if d.HasChange("rate_limits") {
+		limits := expandRateLimits(d.Get("rate_limits").([]any))
+		input.RateLimits = &limits
+	}
+
+	_, err := client.UpdateAnthropicAccessPrivilege(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -8,11 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Apigee dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -23,7 +24,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func getServiceAccountKey(d *schema.ResourceData) *string {
@@ -95,6 +96,8 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		"type":                     string(credential.Type),
 		"kind":                     credential.Kind,
 		"secret_store_id":          credential.SecretStoreID,
+		"secret_store_sync":        credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint":       credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -124,7 +127,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("secret_store_id").(string)
 		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
 	}
-	if d.HasChange("service_account_key") || d.HasChange("service_account_key_wo") || d.HasChange("service_account_key_wo_version") {
+	if d.HasChange("service_account_key") || d.HasChange("service_account_key_wo") || d.HasChange("service_account_key_wo_version") || credutil.ResendSecret(d, "service_account_key", "service_account_key_wo") {
 		input.ServiceAccountKey = getServiceAccountKey(d)
 	}
 
@@ -133,10 +136,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
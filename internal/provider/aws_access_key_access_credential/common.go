@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
@@ -54,7 +56,7 @@ func ResourceSchema() map[string]*schema.Schema {
 		},
 	}
 	s["secret_store_id"] = &schema.Schema{
-		Description:  secretStoreIDDesc,
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
@@ -95,6 +97,8 @@ func ResourceSchema() map[string]*schema.Schema {
 		Default:     false,
 	}
 
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
 	return s
 }
 
@@ -148,5 +152,7 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
 	}
 }
@@ -10,11 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage AWS access key dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -25,7 +26,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func validateKeyPairing(_ context.Context, d *schema.ResourceDiff, _ any) error {
@@ -105,6 +106,8 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		"type":                string(credential.Type),
 		"kind":                credential.Kind,
 		"secret_store_id":     credential.SecretStoreID,
+		"secret_store_sync":   credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint":  credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -138,7 +141,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("access_key_id_value").(string)
 		input.AccessKeyID = &v
 	}
-	if d.HasChange("secret_access_key") || d.HasChange("secret_access_key_wo") || d.HasChange("secret_access_key_wo_version") {
+	if d.HasChange("secret_access_key") || d.HasChange("secret_access_key_wo") || d.HasChange("secret_access_key_wo_version") || credutil.ResendSecret(d, "secret_access_key", "secret_access_key_wo") {
 		secretAccessKey := getSecretAccessKey(d)
 		input.SecretAccessKey = &secretAccessKey
 	}
@@ -152,10 +155,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 func Resource() *schema.Resource {
@@ -76,14 +78,15 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"audience":        credential.Audience,
-		"issuer_url":      credential.IssuerURL,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":              credential.Name,
+		"description":       credential.Description,
+		"deployment_ids":    credential.DeploymentIDs,
+		"audience":          credential.Audience,
+		"issuer_url":        credential.IssuerURL,
+		"type":              string(credential.Type),
+		"kind":              credential.Kind,
+		"secret_store_id":   credential.SecretStoreID,
+		"secret_store_sync": credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
 	}
 
 	for field, value := range fields {
@@ -128,10 +131,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
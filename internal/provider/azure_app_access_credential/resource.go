@@ -10,11 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Azure app dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -25,7 +26,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func validateCredentialPairing(_ context.Context, d *schema.ResourceDiff, _ any) error {
@@ -97,14 +98,16 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"tenant_id":       credential.TenantID,
-		"client_id":       credential.ClientID,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"tenant_id":          credential.TenantID,
+		"client_id":          credential.ClientID,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -142,7 +145,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("client_id").(string)
 		input.ClientID = &v
 	}
-	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") {
+	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") || credutil.ResendSecret(d, "client_secret", "client_secret_wo") {
 		clientSecret := getClientSecret(d)
 		input.ClientSecret = &clientSecret
 	}
@@ -152,10 +155,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
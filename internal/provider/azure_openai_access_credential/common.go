@@ -0,0 +1,210 @@
+package azure_openai_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+var (
+	uuidRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	// ARM naming rules for the Azure locators, mirroring midgard's
+	// AzureResourceGroup and AzureCognitiveServicesAccountName.
+	resourceGroupRegex = regexp.MustCompile(
+		`^[-\p{L}\p{Nl}\p{M}\p{Nd}\p{Pc}\x{200C}\x{200D}.()]*[-\p{L}\p{Nl}\p{M}\p{Nd}\p{Pc}\x{200C}\x{200D}()]$`)
+	accountNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+)
+
+const (
+	idDesc              = "The unique identifier of the Azure OpenAI access credential"
+	nameDesc            = "The name of the Azure OpenAI access credential"
+	descriptionDesc     = "The description of the Azure OpenAI access credential"
+	deploymentIDsDesc   = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	tenantIDDesc        = "The Azure tenant ID (lowercase UUID) of the directory that owns the Azure OpenAI (AI Foundry) account"
+	subscriptionIDDesc  = "The Azure subscription ID (lowercase UUID) that contains the account"
+	resourceGroupDesc   = "The Azure resource group that contains the account"
+	accountNameDesc     = "The name of the Azure OpenAI (AI Foundry) account. Hush mints an Entra ID service principal per user and assigns it access to the account's model deployments"
+	clientIDDesc        = "The client ID of the Azure application Hush uses to manage service principals and role assignments. Must be set together with `client_secret` (or `client_secret_wo`); omit both to use the access-manager's default Azure credentials (managed identity / workload identity)."
+	clientSecretDesc    = "The client secret of the Azure application identified by `client_id`. Must be set together with `client_id`."
+	clientSecretWODesc  = "The client secret of the Azure application identified by `client_id` (write-only). This is a write-only attribute that is more secure than `client_secret` because Terraform will not store this value in the state file. Must be set together with `client_id`."
+	clientSecretWOVDesc = "Used to trigger updates for `client_secret_wo`. This value should be changed when the client secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	typeDesc            = "The type of access credential"
+	kindDesc            = "The kind of access credential"
+	secretStoreIDDesc   = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["tenant_id"] = &schema.Schema{
+		Description:  tenantIDDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(uuidRegex, "tenant_id must be a lowercase UUID"),
+	}
+	s["subscription_id"] = &schema.Schema{
+		Description:  subscriptionIDDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(uuidRegex, "subscription_id must be a lowercase UUID"),
+	}
+	s["resource_group"] = &schema.Schema{
+		Description: resourceGroupDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 90),
+			validation.StringMatch(resourceGroupRegex, "resource_group must contain only letters, digits, '-', '_', '.', '(' or ')' and must not end with a period"),
+		),
+	}
+	s["account_name"] = &schema.Schema{
+		Description: accountNameDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(2, 64),
+			validation.StringMatch(accountNameRegex, "account_name must start with a letter or digit and contain only letters, digits and '-'"),
+		),
+	}
+	// The client_id/client_secret pair is enforced in CustomizeDiff, not via
+	// RequiredWith, so the write-only secret can stand in for the plain one.
+	s["client_id"] = &schema.Schema{
+		Description:  clientIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 256),
+	}
+	s["client_secret"] = &schema.Schema{
+		Description:   clientSecretDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"client_secret_wo"},
+	}
+	s["client_secret_wo"] = &schema.Schema{
+		Description:   clientSecretWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"client_secret"},
+		RequiredWith:  []string{"client_secret_wo_version"},
+	}
+	s["client_secret_wo_version"] = &schema.Schema{
+		Description:  clientSecretWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"client_secret_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tenant_id": {
+			Description: tenantIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subscription_id": {
+			Description: subscriptionIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_group": {
+			Description: resourceGroupDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"account_name": {
+			Description: accountNameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"client_id": {
+			Description: clientIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
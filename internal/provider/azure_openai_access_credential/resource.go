@@ -0,0 +1,255 @@
+package azure_openai_access_credential
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+func Resource() *schema.Resource {
+	return credutil.WithSecretDrift(&schema.Resource{
+		Description:   "Manage Azure OpenAI (AI Foundry) dynamic access credentials in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: customizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	})
+}
+
+// customizeDiff rejects deployment_ids changes after creation and enforces the
+// API's rules for the optional app credentials.
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if err := credutil.ForbidDeploymentIDsChange(ctx, d, meta); err != nil {
+		return err
+	}
+	return validateAppCredentials(d)
+}
+
+// validateAppCredentials enforces the two rules the API applies to the app
+// credentials: client_id and client_secret come as a pair (omit both to fall
+// back to the access-manager's default Azure credentials), and a stored secret
+// is issued for one app in one tenant, so re-pointing either half requires a
+// fresh secret.
+func validateAppCredentials(d *schema.ResourceDiff) error {
+	hasID := rawSet(d, "client_id")
+	hasSecret := rawSet(d, "client_secret") || rawSet(d, "client_secret_wo")
+	if hasID != hasSecret {
+		return fmt.Errorf("client_id and client_secret must both be set or both be omitted " +
+			"(omit both to use the access-manager's default Azure credentials)")
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	var rebound []string
+	for _, f := range []string{"client_id", "tenant_id"} {
+		if d.HasChange(f) {
+			rebound = append(rebound, f)
+		}
+	}
+	// A rotation is signalled by the plain secret changing or by the write-only
+	// secret's version being bumped; the write-only value itself is not in state.
+	secretResent := d.HasChange("client_secret") || d.HasChange("client_secret_wo_version")
+	if len(rebound) > 0 && !secretResent {
+		// With no stored client_id there is no secret to rotate: the API rejects
+		// the move either way, but adopting the pair is what unblocks it.
+		if stored, _ := d.GetChange("client_id"); stored.(string) == "" {
+			return fmt.Errorf("this credential uses the access-manager's default Azure credentials; changing %s "+
+				"requires setting client_id and client_secret together in the same change, or recreating the credential",
+				strings.Join(rebound, ", "))
+		}
+		return fmt.Errorf("changing %s requires a new client_secret (or client_secret_wo with a bumped client_secret_wo_version)",
+			strings.Join(rebound, ", "))
+	}
+
+	return nil
+}
+
+// rawSet reports whether attr is configured in raw config. An unknown value (a
+// reference resolved at apply) counts as set and is validated by the backend.
+func rawSet(d *schema.ResourceDiff, attr string) bool {
+	rc := d.GetRawConfig()
+	if rc.IsNull() {
+		return false
+	}
+	v := rc.GetAttr(attr)
+	if v.IsNull() {
+		return false
+	}
+	if !v.IsKnown() {
+		return true
+	}
+	if v.Type() == cty.String {
+		return v.AsString() != ""
+	}
+	return true
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	deploymentIDs := make([]string, 0)
+	if v, ok := d.GetOk("deployment_ids"); ok {
+		for _, item := range v.([]any) {
+			deploymentIDs = append(deploymentIDs, item.(string))
+		}
+	}
+
+	input := &client.CreateAzureOpenAIAccessCredentialInput{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		DeploymentIDs:  deploymentIDs,
+		SecretStoreID:  d.Get("secret_store_id").(string),
+		TenantID:       d.Get("tenant_id").(string),
+		SubscriptionID: d.Get("subscription_id").(string),
+		ResourceGroup:  d.Get("resource_group").(string),
+		AccountName:    d.Get("account_name").(string),
+		ClientID:       d.Get("client_id").(string),
+		ClientSecret:   writeonly.GetString(d, "client_secret", "client_secret_wo"),
+	}
+
+	credential, err := client.CreateAzureOpenAIAccessCredential(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	credential, err := client.GetAzureOpenAIAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	fields := map[string]any{
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"tenant_id":          credential.TenantID,
+		"subscription_id":    credential.SubscriptionID,
+		"resource_group":     credential.ResourceGroup,
+		"account_name":       credential.AccountName,
+		"client_id":          credential.ClientID,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateAzureOpenAIAccessCredentialInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("secret_store_id") {
+		v := d.Get("secret_store_id").(string)
+		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
+	}
+	if d.HasChange("tenant_id") {
+		v := d.Get("tenant_id").(string)
+		input.TenantID = &v
+	}
+	if d.HasChange("subscription_id") {
+		v := d.Get("subscription_id").(string)
+		input.SubscriptionID = &v
+	}
+	if d.HasChange("resource_group") {
+		v := d.Get("resource_group").(string)
+		input.ResourceGroup = &v
+	}
+	if d.HasChange("account_name") {
+		v := d.Get("account_name").(string)
+		input.AccountName = &v
+	}
+	if d.HasChange("client_id") {
+		input.ClientID = client.NewNullableString(d.Get("client_id").(string))
+	}
+	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") || credutil.ResendSecret(d, "client_secret", "client_secret_wo") {
+		input.ClientSecret = client.NewNullableString(writeonly.GetString(d, "client_secret", "client_secret_wo"))
+	}
+
+	_, err := client.UpdateAzureOpenAIAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
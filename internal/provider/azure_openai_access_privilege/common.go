@@ -0,0 +1,101 @@
+package azure_openai_access_privilege
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	idDesc              = "The unique identifier of the Azure OpenAI access privilege"
+	nameDesc            = "The name of the Azure OpenAI access privilege"
+	descriptionDesc     = "The description of the Azure OpenAI access privilege"
+	deploymentsDesc     = "The model deployments of the credential's account the minted identities can call (e.g., gpt-4o-prod). Use `*` for every deployment"
+	tokensPerMinuteDesc = "The tokens-per-minute quota of each minted identity, across the granted deployments. Omit for no quota beyond the deployments' own limits"
+	typeDesc            = "The type of access privilege"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployments"] = &schema.Schema{
+		Description: deploymentsDesc,
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+	}
+	s["tokens_per_minute"] = &schema.Schema{
+		Description:  tokensPerMinuteDesc,
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1000),
+	}
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployments": {
+			Description: deploymentsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tokens_per_minute": {
+			Description: tokensPerMinuteDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func expandDeployments(list []any) []string {
+	deployments := make([]string, len(list))
+	for i, v := range list {
+		deployments[i] = v.(string)
+	}
+	return deployments
+}
@@ -0,0 +1,156 @@
+package azure_openai_access_privilege
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage Azure OpenAI access privileges in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: validateDeployments,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	}
+}
+
+// validateDeployments rejects duplicate deployments and `*` alongside named
+// ones, which it already covers.
+func validateDeployments(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("deployments") {
+		return nil
+	}
+	deployments := expandDeployments(d.Get("deployments").([]any))
+	if len(deployments) > 1 && slices.Contains(deployments, "*") {
+		return fmt.Errorf(`deployments: "*" already covers every deployment and cannot be combined with named ones`)
+	}
+	for i, name := range deployments {
+		if slices.Contains(deployments[:i], name) {
+			return fmt.Errorf("deployments: %q is listed more than once", name)
+		}
+	}
+	return nil
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	input := &client.CreateAzureOpenAIAccessPrivilegeInput{
+		Name:            d.Get("name").(string),
+		Deployments:     expandDeployments(d.Get("deployments").([]any)),
+		TokensPerMinute: d.Get("tokens_per_minute").(int),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = v.(string)
+	}
+
+	privilege, err := client.CreateAzureOpenAIAccessPrivilege(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	privilege, err := client.GetAzureOpenAIAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	fields := map[string]any{
+		"name":              privilege.Name,
+		"description":       privilege.Description,
+		"deployments":       privilege.Deployments,
+		"tokens_per_minute": privilege.TokensPerMinute,
+		"type":              privilege.Type,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateAzureOpenAIAccessPrivilegeInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("deployments") {
+		deployments := expandDeployments(d.Get("deployments").([]any))
+		input.Deployments = &deployments
+	}
+	if d.HasChange("tokens_per_minute") {
+		v := d.Get("tokens_per_minute").(int)
+		input.TokensPerMinute = &v
+	}
+
+	_, err := client.UpdateAzureOpenAIAccessPrivilege(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
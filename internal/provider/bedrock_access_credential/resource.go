@@ -9,11 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Bedrock dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -24,7 +25,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func getSecretAccessKey(d *schema.ResourceData) *string {
@@ -111,6 +112,8 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		"type":                     string(credential.Type),
 		"kind":                     credential.Kind,
 		"secret_store_id":          credential.SecretStoreID,
+		"secret_store_sync":        credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint":       credential.SecretFingerprint,
 	}
 
 	if credential.AccessKeyID != nil {
@@ -154,7 +157,8 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	awsKeysChanged := d.HasChange("access_key_id") ||
 		d.HasChange("secret_access_key") ||
 		d.HasChange("secret_access_key_wo") ||
-		d.HasChange("secret_access_key_wo_version")
+		d.HasChange("secret_access_key_wo_version") ||
+		credutil.ResendSecret(d, "secret_access_key", "secret_access_key_wo")
 
 	if awsKeysChanged {
 		if v, ok := d.GetOk("access_key_id"); ok {
@@ -185,10 +189,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
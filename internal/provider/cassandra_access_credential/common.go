@@ -0,0 +1,281 @@
+package cassandra_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+const (
+	engineCassandra = "cassandra"
+	engineScylla    = "scylla"
+	engineKeyspaces = "keyspaces"
+)
+
+var validEngines = []string{engineCassandra, engineScylla, engineKeyspaces}
+
+const (
+	idDesc                 = "The unique identifier of the Cassandra access credential"
+	nameDesc               = "The name of the Cassandra access credential"
+	descriptionDesc        = "The description of the Cassandra access credential"
+	deploymentIDsDesc      = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	engineDesc             = "The database engine: `cassandra` for Apache Cassandra, `scylla` for ScyllaDB, or `keyspaces` for Amazon Keyspaces, which authenticates with AWS SigV4 instead of a password. Immutable; changing it forces replacement."
+	hostsDesc              = "The contact points of the cluster, as hostnames or IP addresses. Required when `engine` is `cassandra` or `scylla`; must not be set when `engine` is `keyspaces` (Hush connects to the regional endpoint)."
+	portDesc               = "The CQL native protocol port (default: 9042). Only valid when `engine` is `cassandra` or `scylla`."
+	localDatacenterDesc    = "The datacenter the short-lived users' connections are routed to, for clusters that span several. Leave empty to use any datacenter. Only valid when `engine` is `cassandra` or `scylla`."
+	tlsDesc                = "Whether to use TLS when connecting to the cluster. Only valid when `engine` is `cassandra` or `scylla`; Amazon Keyspaces always uses TLS."
+	tlsCADesc              = "The TLS CA certificate for the cluster connection. Only valid when `engine` is `cassandra` or `scylla`."
+	usernameDesc           = "The admin role for the cluster connection. It needs permission to create roles and to grant the permissions in the access privilege. Required when `engine` is `cassandra` or `scylla`."
+	passwordDesc           = "The password of the admin role (required when `engine` is `cassandra` or `scylla`)."
+	passwordWODesc         = "The password of the admin role (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Used when `engine` is `cassandra` or `scylla`."
+	passwordWOVerDesc      = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	regionDesc             = "The AWS region of the Amazon Keyspaces endpoint. Required and only valid when `engine` is `keyspaces`."
+	accessKeyIDDesc        = "The AWS access key ID used to sign requests to Amazon Keyspaces and IAM. Only valid when `engine` is `keyspaces`. Must be set together with `secret_access_key` (or `secret_access_key_wo`); omit both to use AWS workload identity federation (IRSA / instance profile / WIF)."
+	secretAccessKeyDesc    = "The AWS secret access key used to sign requests to Amazon Keyspaces and IAM. Only valid when `engine` is `keyspaces`. Must be set together with `access_key_id`. Omit both to use AWS workload identity federation."
+	secretAccessKeyWODesc  = "The AWS secret access key (write-only). This is a write-only attribute that is more secure than `secret_access_key` because Terraform will not store this value in the state file. Only valid when `engine` is `keyspaces`. Must be set together with `access_key_id`; omit both to use AWS workload identity federation."
+	secretAccessKeyWOVDesc = "Used to trigger updates for `secret_access_key_wo`. This value should be changed when the secret content changes."
+	typeDesc               = "The type of access credential"
+	kindDesc               = "The kind of access credential"
+	secretStoreIDDesc      = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["engine"] = &schema.Schema{
+		Description:  engineDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(validEngines, false),
+	}
+	// The connection fields are Optional (not Required) because the keyspaces
+	// engine must not set them; per-engine requiredness is enforced in
+	// CustomizeDiff (validateEngineFields).
+	s["hosts"] = &schema.Schema{
+		Description: hostsDesc,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+	s["port"] = &schema.Schema{
+		Description: portDesc,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	}
+	s["local_datacenter"] = &schema.Schema{
+		Description: localDatacenterDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["tls"] = &schema.Schema{
+		Description: tlsDesc,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["tls_ca"] = &schema.Schema{
+		Description: tlsCADesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["username"] = &schema.Schema{
+		Description: usernameDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["password"] = &schema.Schema{
+		Description:   passwordDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"password_wo"},
+	}
+	s["password_wo"] = &schema.Schema{
+		Description:   passwordWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"password"},
+		RequiredWith:  []string{"password_wo_version"},
+	}
+	s["password_wo_version"] = &schema.Schema{
+		Description:  passwordWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+	}
+	// Keyspaces-engine fields.
+	s["region"] = &schema.Schema{
+		Description: regionDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["access_key_id"] = &schema.Schema{
+		Description: accessKeyIDDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["secret_access_key"] = &schema.Schema{
+		Description:   secretAccessKeyDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"secret_access_key_wo"},
+	}
+	s["secret_access_key_wo"] = &schema.Schema{
+		Description:   secretAccessKeyWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"secret_access_key"},
+		RequiredWith:  []string{"secret_access_key_wo_version"},
+	}
+	s["secret_access_key_wo_version"] = &schema.Schema{
+		Description:  secretAccessKeyWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"secret_access_key_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"engine": {
+			Description: engineDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"hosts": {
+			Description: hostsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"port": {
+			Description: portDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"local_datacenter": {
+			Description: localDatacenterDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tls": {
+			Description: tlsDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"tls_ca": {
+			Description: tlsCADesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description: usernameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"region": {
+			Description: regionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"access_key_id": {
+			Description: accessKeyIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
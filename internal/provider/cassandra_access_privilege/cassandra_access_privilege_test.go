@@ -0,0 +1,59 @@
+package cassandra_access_privilege
+
+import (
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandGrants(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"permissions": []any{"SELECT", "MODIFY"},
+			"keyspace":    "orders",
+			"table":       "line_items",
+		},
+		map[string]any{
+			"permissions": []any{"SELECT"},
+			"keyspace":    "",
+			"table":       "",
+		},
+	}
+
+	result := expandGrants(input)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 grants, got %d", len(result))
+	}
+	if result[0].Keyspace != "orders" || result[0].Table != "line_items" {
+		t.Errorf("unexpected table grant: %+v", result[0])
+	}
+	if len(result[0].Permissions) != 2 || result[0].Permissions[1] != "MODIFY" {
+		t.Errorf("unexpected permissions: %v", result[0].Permissions)
+	}
+	if result[1].Keyspace != "" || result[1].Table != "" {
+		t.Errorf("expected an all-keyspaces grant, got %+v", result[1])
+	}
+}
+
+func TestFlattenGrants(t *testing.T) {
+	input := []client.CassandraGrant{
+		{Keyspace: "orders"},
+	}
+
+	result := flattenGrants(input)
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 grant, got %d", len(result))
+	}
+	m := result[0].(map[string]any)
+	if m["keyspace"] != "orders" {
+		t.Errorf("expected keyspace 'orders', got '%v'", m["keyspace"])
+	}
+	if m["table"] != "" {
+		t.Errorf("expected empty table, got '%v'", m["table"])
+	}
+	if permissions := m["permissions"].([]string); len(permissions) != 0 {
+		t.Errorf("expected empty permissions, got %v", permissions)
+	}
+}
@@ -0,0 +1,163 @@
+package cassandra_access_privilege
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	idDesc          = "The unique identifier of the Cassandra access privilege"
+	nameDesc        = "The name of the Cassandra access privilege"
+	descriptionDesc = "The description of the Cassandra access privilege"
+	grantsDesc      = "The list of permission grants on keyspaces and tables"
+	typeDesc        = "The type of access privilege"
+)
+
+// validPermissions are the CQL permissions that apply to keyspace and table
+// resources. DESCRIBE and EXECUTE only apply to roles and functions, which a
+// privilege does not grant on.
+var validPermissions = []string{"ALL", "ALTER", "AUTHORIZE", "CREATE", "DROP", "MODIFY", "SELECT"}
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["grants"] = &schema.Schema{
+		Description: grantsDesc,
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permissions": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(validPermissions, false),
+					},
+					Description: "The list of CQL permissions (ALL, ALTER, AUTHORIZE, CREATE, DROP, MODIFY, SELECT)",
+				},
+				"keyspace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The keyspace the permissions apply to. Leave empty for all keyspaces",
+				},
+				"table": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The table in `keyspace` the permissions apply to. Leave empty for the whole keyspace",
+				},
+			},
+		},
+	}
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"grants": {
+			Description: grantsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"permissions": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The list of CQL permissions",
+					},
+					"keyspace": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The keyspace the permissions apply to, empty for all keyspaces",
+					},
+					"table": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The table the permissions apply to, empty for the whole keyspace",
+					},
+				},
+			},
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func expandStrings(list []any) []string {
+	result := make([]string, len(list))
+	for i, v := range list {
+		result[i] = v.(string)
+	}
+	return result
+}
+
+func expandGrants(list []any) []client.CassandraGrant {
+	grants := make([]client.CassandraGrant, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		grants[i] = client.CassandraGrant{
+			Permissions: expandStrings(m["permissions"].([]any)),
+			Keyspace:    m["keyspace"].(string),
+			Table:       m["table"].(string),
+		}
+	}
+	return grants
+}
+
+func flattenGrants(grants []client.CassandraGrant) []any {
+	result := make([]any, len(grants))
+	for i, g := range grants {
+		permissions := g.Permissions
+		if permissions == nil {
+			permissions = []string{}
+		}
+		result[i] = map[string]any{
+			"permissions": permissions,
+			"keyspace":    g.Keyspace,
+			"table":       g.Table,
+		}
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package cassandra_access_privilege
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage Cassandra access privileges in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: validateGrants,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	}
+}
+
+// validateGrants rejects a table without a keyspace, and CREATE on a table: CQL
+// only grants CREATE on keyspaces, where it covers creating their tables.
+func validateGrants(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for i, v := range d.Get("grants").([]any) {
+		m, ok := v.(map[string]any)
+		if !ok || m["table"].(string) == "" {
+			continue
+		}
+		if m["keyspace"].(string) == "" && d.NewValueKnown(fmt.Sprintf("grants.%d.keyspace", i)) {
+			return fmt.Errorf("grants.%d: table requires keyspace", i)
+		}
+		for _, p := range m["permissions"].([]any) {
+			if p == "CREATE" {
+				return fmt.Errorf("grants.%d: CREATE cannot be granted on a table; grant it on the keyspace instead", i)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	input := &client.CreateCassandraAccessPrivilegeInput{
+		Name:   d.Get("name").(string),
+		Grants: expandGrants(d.Get("grants").([]any)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = v.(string)
+	}
+
+	privilege, err := client.CreateCassandraAccessPrivilege(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	privilege, err := client.GetCassandraAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	fields := map[string]any{
+		"name":        privilege.Name,
+		"description": privilege.Description,
+		"grants":      flattenGrants(privilege.Grants),
+		"type":        privilege.Type,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateCassandraAccessPrivilegeInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("grants") {
+		grants := expandGrants(d.Get("grants").([]any))
+		input.Grants = &grants
+	}
+
+	_, err := client.UpdateCassandraAccessPrivilege(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
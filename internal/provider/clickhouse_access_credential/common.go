@@ -0,0 +1,284 @@
+package clickhouse_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+const (
+	engineNative          = "native"
+	engineClickHouseCloud = "clickhouse_cloud"
+)
+
+var validEngines = []string{engineNative, engineClickHouseCloud}
+
+const (
+	idDesc             = "The unique identifier of the ClickHouse access credential"
+	nameDesc           = "The name of the ClickHouse access credential"
+	descriptionDesc    = "The description of the ClickHouse access credential"
+	deploymentIDsDesc  = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	engineDesc         = "The ClickHouse engine: `native` for a self-managed ClickHouse server or cluster, or `clickhouse_cloud` for a ClickHouse Cloud service. Immutable; changing it forces replacement."
+	hostDesc           = "The hostname or IP address of the ClickHouse server. Required when `engine` is `native`."
+	portDesc           = "The port of the ClickHouse native protocol (default: 9440 with TLS, 9000 without). Only valid when `engine` is `native`."
+	tlsDesc            = "Whether to use TLS when connecting to the ClickHouse server. Only valid when `engine` is `native`."
+	tlsCADesc          = "The TLS CA certificate for the ClickHouse connection. Only valid when `engine` is `native`."
+	clusterDesc        = "The cluster the short-lived users are created on, with `ON CLUSTER`, so that every replica accepts them. Leave empty for a single server. Only valid when `engine` is `native`."
+	usernameDesc       = "The admin user for the ClickHouse connection. It needs the ACCESS MANAGEMENT privilege. Required when `engine` is `native`."
+	passwordDesc       = "The password of the admin user (required when `engine` is `native`)."
+	passwordWODesc     = "The password of the admin user (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Used when `engine` is `native`."
+	passwordWOVerDesc  = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	organizationIDDesc = "The ClickHouse Cloud organization that owns the service. Required when `engine` is `clickhouse_cloud`."
+	serviceIDDesc      = "The ClickHouse Cloud service ID. Required when `engine` is `clickhouse_cloud`."
+	keyIDDesc          = "The ID of the ClickHouse Cloud API key used to manage the service. The key needs the Admin role. Required when `engine` is `clickhouse_cloud`."
+	keySecretDesc      = "The secret of the ClickHouse Cloud API key (required when `engine` is `clickhouse_cloud`)."
+	keySecretWODesc    = "The secret of the ClickHouse Cloud API key (write-only). This is a write-only attribute that is more secure than `key_secret` because Terraform will not store this value in the state file. Used when `engine` is `clickhouse_cloud`."
+	keySecretWOVerDesc = "Used to trigger updates for `key_secret_wo`. This value should be changed when the key secret changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	typeDesc           = "The type of access credential"
+	kindDesc           = "The kind of access credential"
+	secretStoreIDDesc  = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["engine"] = &schema.Schema{
+		Description:  engineDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(validEngines, false),
+	}
+	// Native-engine fields. Optionality is enforced per-engine in CustomizeDiff
+	// (validateEngineFields) because the SDK schema cannot express
+	// conditional-required attributes.
+	s["host"] = &schema.Schema{
+		Description: hostDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	// port is Computed so that the API's TLS-dependent default is kept.
+	s["port"] = &schema.Schema{
+		Description: portDesc,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	}
+	s["tls"] = &schema.Schema{
+		Description: tlsDesc,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["tls_ca"] = &schema.Schema{
+		Description: tlsCADesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["cluster"] = &schema.Schema{
+		Description: clusterDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["username"] = &schema.Schema{
+		Description: usernameDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["password"] = &schema.Schema{
+		Description:   passwordDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"password_wo"},
+	}
+	s["password_wo"] = &schema.Schema{
+		Description:   passwordWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"password"},
+		RequiredWith:  []string{"password_wo_version"},
+	}
+	s["password_wo_version"] = &schema.Schema{
+		Description:  passwordWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+	}
+	// ClickHouse Cloud-engine fields.
+	s["organization_id"] = &schema.Schema{
+		Description: organizationIDDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["service_id"] = &schema.Schema{
+		Description: serviceIDDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["key_id"] = &schema.Schema{
+		Description: keyIDDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["key_secret"] = &schema.Schema{
+		Description:   keySecretDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"key_secret_wo"},
+	}
+	s["key_secret_wo"] = &schema.Schema{
+		Description:   keySecretWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"key_secret"},
+		RequiredWith:  []string{"key_secret_wo_version"},
+	}
+	s["key_secret_wo_version"] = &schema.Schema{
+		Description:  keySecretWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"key_secret_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"engine": {
+			Description: engineDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"host": {
+			Description: hostDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"port": {
+			Description: portDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"tls": {
+			Description: tlsDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"tls_ca": {
+			Description: tlsCADesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster": {
+			Description: clusterDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description: usernameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"organization_id": {
+			Description: organizationIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"service_id": {
+			Description: serviceIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"key_id": {
+			Description: keyIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
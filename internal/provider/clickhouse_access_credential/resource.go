@@ -0,0 +1,306 @@
+package clickhouse_access_credential
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+func Resource() *schema.Resource {
+	return credutil.WithSecretDrift(&schema.Resource{
+		Description:   "Manage ClickHouse dynamic access credentials in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: customizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	})
+}
+
+// customizeDiff rejects deployment_ids changes after creation and enforces the
+// per-engine field rules: every engine requires its connection fields and
+// secret, and forbids the other engine's fields.
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if err := credutil.ForbidDeploymentIDsChange(ctx, d, meta); err != nil {
+		return err
+	}
+	return validateEngineFields(d)
+}
+
+func validateEngineFields(d *schema.ResourceDiff) error {
+	engine := d.Get("engine").(string)
+
+	var required, forbidden []string
+	switch engine {
+	case engineNative:
+		required = []string{"host", "username", "password"}
+		forbidden = []string{"organization_id", "service_id", "key_id", "key_secret"}
+	case engineClickHouseCloud:
+		required = []string{"organization_id", "service_id", "key_id", "key_secret"}
+		forbidden = []string{"host", "port", "tls", "tls_ca", "cluster", "username", "password"}
+	default:
+		return nil
+	}
+
+	var missing []string
+	for _, f := range required {
+		if !attrSet(d, f) {
+			missing = append(missing, f)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("engine %q requires: %s", engine, strings.Join(missing, ", "))
+	}
+
+	var present []string
+	for _, f := range forbidden {
+		if attrSet(d, f) {
+			present = append(present, f)
+		}
+	}
+	if len(present) > 0 {
+		return fmt.Errorf("engine %q does not allow: %s", engine, strings.Join(present, ", "))
+	}
+
+	return nil
+}
+
+// attrSet reports whether attr is configured. Each secret may be supplied via
+// its plain attribute or its write-only counterpart, so either counts as set.
+func attrSet(d *schema.ResourceDiff, attr string) bool {
+	switch attr {
+	case "password":
+		return rawSet(d, "password") || rawSet(d, "password_wo")
+	case "key_secret":
+		return rawSet(d, "key_secret") || rawSet(d, "key_secret_wo")
+	default:
+		return rawSet(d, attr)
+	}
+}
+
+// rawSet reports whether attr is configured in raw config. An unknown value (a
+// reference resolved at apply, e.g. random_password.x.result) counts as set and
+// is validated by the backend; null does not, so schema defaults stay unset.
+func rawSet(d *schema.ResourceDiff, attr string) bool {
+	rc := d.GetRawConfig()
+	if rc.IsNull() {
+		return false
+	}
+	v := rc.GetAttr(attr)
+	if v.IsNull() {
+		return false
+	}
+	if !v.IsKnown() {
+		return true
+	}
+	if v.Type() == cty.String {
+		return v.AsString() != ""
+	}
+	return true
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	deploymentIDs := make([]string, 0)
+	if v, ok := d.GetOk("deployment_ids"); ok {
+		for _, item := range v.([]any) {
+			deploymentIDs = append(deploymentIDs, item.(string))
+		}
+	}
+
+	engine := d.Get("engine").(string)
+	input := &client.CreateClickHouseAccessCredentialInput{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DeploymentIDs: deploymentIDs,
+		SecretStoreID: d.Get("secret_store_id").(string),
+		Engine:        engine,
+	}
+
+	switch engine {
+	case engineNative:
+		input.Host = d.Get("host").(string)
+		input.Port = d.Get("port").(int)
+		input.TLS = d.Get("tls").(bool)
+		input.TLSCA = d.Get("tls_ca").(string)
+		input.Cluster = d.Get("cluster").(string)
+		input.Username = d.Get("username").(string)
+		input.Password = writeonly.GetString(d, "password", "password_wo")
+	case engineClickHouseCloud:
+		input.OrganizationID = d.Get("organization_id").(string)
+		input.ServiceID = d.Get("service_id").(string)
+		input.KeyID = d.Get("key_id").(string)
+		input.KeySecret = writeonly.GetString(d, "key_secret", "key_secret_wo")
+	}
+
+	credential, err := client.CreateClickHouseAccessCredential(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	credential, err := client.GetClickHouseAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	fields := map[string]any{
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"engine":             credential.Engine,
+		"host":               credential.Host,
+		"port":               credential.Port,
+		"tls":                credential.TLS,
+		"tls_ca":             credential.TLSCA,
+		"cluster":            credential.Cluster,
+		"username":           credential.Username,
+		"organization_id":    credential.OrganizationID,
+		"service_id":         credential.ServiceID,
+		"key_id":             credential.KeyID,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateClickHouseAccessCredentialInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("secret_store_id") {
+		v := d.Get("secret_store_id").(string)
+		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
+	}
+	if d.HasChange("host") {
+		v := d.Get("host").(string)
+		input.Host = &v
+	}
+	if d.HasChange("port") {
+		v := d.Get("port").(int)
+		input.Port = &v
+	}
+	if d.HasChange("tls") {
+		v := d.Get("tls").(bool)
+		input.TLS = &v
+	}
+	if d.HasChange("tls_ca") {
+		v := d.Get("tls_ca").(string)
+		input.TLSCA = &v
+	}
+	if d.HasChange("cluster") {
+		input.Cluster = client.NewNullableString(d.Get("cluster").(string))
+	}
+	if d.HasChange("username") {
+		v := d.Get("username").(string)
+		input.Username = &v
+	}
+	if d.HasChange("organization_id") {
+		v := d.Get("organization_id").(string)
+		input.OrganizationID = &v
+	}
+	if d.HasChange("service_id") {
+		v := d.Get("service_id").(string)
+		input.ServiceID = &v
+	}
+	if d.HasChange("key_id") {
+		v := d.Get("key_id").(string)
+		input.KeyID = &v
+	}
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
+		password := writeonly.GetString(d, "password", "password_wo")
+		input.Password = &password
+	}
+	if d.HasChange("key_secret") || d.HasChange("key_secret_wo") || d.HasChange("key_secret_wo_version") || credutil.ResendSecret(d, "key_secret", "key_secret_wo") {
+		keySecret := writeonly.GetString(d, "key_secret", "key_secret_wo")
+		input.KeySecret = &keySecret
+	}
+
+	_, err := client.UpdateClickHouseAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package clickhouse_access_privilege
+
+import (
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandGrants(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"privileges": []any{"SELECT", "INSERT"},
+			"database":   "events",
+			"tables":     []any{"page_views"},
+		},
+		map[string]any{
+			"privileges": []any{"SHOW TABLES"},
+			"database":   "*",
+			"tables":     []any{},
+		},
+	}
+
+	result := expandGrants(input)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 grants, got %d", len(result))
+	}
+	if result[0].Database != "events" || len(result[0].Tables) != 1 || result[0].Tables[0] != "page_views" {
+		t.Errorf("unexpected first grant: %+v", result[0])
+	}
+	if result[1].Tables != nil {
+		t.Errorf("expected no tables, got %v", result[1].Tables)
+	}
+}
+
+func TestRowPoliciesRoundTrip(t *testing.T) {
+	policies := []client.ClickHouseRowPolicy{
+		{Database: "events", Table: "page_views", Condition: "tenant_id = 42"},
+	}
+
+	result := expandRowPolicies(flattenRowPolicies(policies))
+
+	if len(result) != 1 || result[0] != policies[0] {
+		t.Errorf("expected %v, got %v", policies, result)
+	}
+}
@@ -0,0 +1,259 @@
+package clickhouse_access_privilege
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	idDesc              = "The unique identifier of the ClickHouse access privilege"
+	nameDesc            = "The name of the ClickHouse access privilege"
+	descriptionDesc     = "The description of the ClickHouse access privilege"
+	grantsDesc          = "The list of privilege grants on databases and tables"
+	settingsProfileDesc = "The name of an existing settings profile assigned to the short-lived users, for example to cap `max_memory_usage` or make them `readonly`"
+	rowPoliciesDesc     = "Row policies that limit which rows of a table the short-lived users can read. Without a row policy on a table, they read every row their grants allow"
+	typeDesc            = "The type of access privilege"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["grants"] = &schema.Schema{
+		Description: grantsDesc,
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privileges": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The list of ClickHouse privileges (e.g., SELECT, INSERT, ALTER UPDATE, SHOW TABLES)",
+				},
+				"database": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The database the privileges apply to, or `*` for every database",
+				},
+				"tables": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The tables the privileges apply to. Leave empty for every table in the database",
+				},
+			},
+		},
+	}
+	s["settings_profile"] = &schema.Schema{
+		Description: settingsProfileDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["row_policies"] = &schema.Schema{
+		Description: rowPoliciesDesc,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The database of the table",
+				},
+				"table": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The table the policy applies to",
+				},
+				"condition": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "A ClickHouse boolean expression rows must match to be read, for example `tenant_id = 42`",
+				},
+			},
+		},
+	}
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"grants": {
+			Description: grantsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"privileges": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The list of ClickHouse privileges",
+					},
+					"database": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The database the privileges apply to",
+					},
+					"tables": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The tables the privileges apply to",
+					},
+				},
+			},
+		},
+		"settings_profile": {
+			Description: settingsProfileDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"row_policies": {
+			Description: rowPoliciesDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"database": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The database of the table",
+					},
+					"table": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The table the policy applies to",
+					},
+					"condition": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The boolean expression rows must match to be read",
+					},
+				},
+			},
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func expandStrings(list []any) []string {
+	result := make([]string, len(list))
+	for i, v := range list {
+		result[i] = v.(string)
+	}
+	return result
+}
+
+func expandGrants(list []any) []client.ClickHouseGrant {
+	grants := make([]client.ClickHouseGrant, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		grant := client.ClickHouseGrant{
+			Privileges: expandStrings(m["privileges"].([]any)),
+			Database:   m["database"].(string),
+		}
+		if tables, ok := m["tables"].([]any); ok && len(tables) > 0 {
+			grant.Tables = expandStrings(tables)
+		}
+		grants[i] = grant
+	}
+	return grants
+}
+
+func flattenGrants(grants []client.ClickHouseGrant) []any {
+	result := make([]any, len(grants))
+	for i, g := range grants {
+		privileges := g.Privileges
+		if privileges == nil {
+			privileges = []string{}
+		}
+		tables := g.Tables
+		if tables == nil {
+			tables = []string{}
+		}
+		result[i] = map[string]any{
+			"privileges": privileges,
+			"database":   g.Database,
+			"tables":     tables,
+		}
+	}
+	return result
+}
+
+func expandRowPolicies(list []any) []client.ClickHouseRowPolicy {
+	policies := make([]client.ClickHouseRowPolicy, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		policies[i] = client.ClickHouseRowPolicy{
+			Database:  m["database"].(string),
+			Table:     m["table"].(string),
+			Condition: m["condition"].(string),
+		}
+	}
+	return policies
+}
+
+func flattenRowPolicies(policies []client.ClickHouseRowPolicy) []any {
+	result := make([]any, len(policies))
+	for i, p := range policies {
+		result[i] = map[string]any{
+			"database":  p.Database,
+			"table":     p.Table,
+			"condition": p.Condition,
+		}
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package clickhouse_access_privilege
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage ClickHouse access privileges in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	}
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	input := &client.CreateClickHouseAccessPrivilegeInput{
+		Name:            d.Get("name").(string),
+		Grants:          expandGrants(d.Get("grants").([]any)),
+		SettingsProfile: d.Get("settings_profile").(string),
+		RowPolicies:     expandRowPolicies(d.Get("row_policies").([]any)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = v.(string)
+	}
+
+	privilege, err := client.CreateClickHouseAccessPrivilege(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	privilege, err := client.GetClickHouseAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privilege.ID)
+
+	fields := map[string]any{
+		"name":             privilege.Name,
+		"description":      privilege.Description,
+		"grants":           flattenGrants(privilege.Grants),
+		"settings_profile": privilege.SettingsProfile,
+		"row_policies":     flattenRowPolicies(privilege.RowPolicies),
+		"type":             privilege.Type,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateClickHouseAccessPrivilegeInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("grants") {
+		grants := expandGrants(d.Get("grants").([]any))
+		input.Grants = &grants
+	}
+	if d.HasChange("settings_profile") {
+		input.SettingsProfile = client.NewNullableString(d.Get("settings_profile").(string))
+	}
+	if d.HasChange("row_policies") {
+		policies := expandRowPolicies(d.Get("row_policies").([]any))
+		input.RowPolicies = &policies
+	}
+
+	_, err := client.UpdateClickHouseAccessPrivilege(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessPrivilege(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
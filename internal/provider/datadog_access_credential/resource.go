@@ -8,11 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Datadog dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -23,7 +24,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func getAPIKey(d *schema.ResourceData) string {
@@ -51,6 +52,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		SecretStoreID: d.Get("secret_store_id").(string),
 		APIKey:        getAPIKey(d),
 		AppKey:        getAppKey(d),
+		Rotation:      credutil.ExpandRotation(d.Get("rotation").([]any)),
 	}
 
 	if v, ok := d.GetOk("site"); ok {
@@ -93,13 +95,16 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"site":            credential.Site,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"site":               credential.Site,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
+		"rotation":           credutil.FlattenRotation(credential.Rotation, d),
 	}
 
 	for field, value := range fields {
@@ -133,24 +138,40 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("site").(string)
 		input.Site = &v
 	}
-	if d.HasChange("api_key") || d.HasChange("api_key_wo") || d.HasChange("api_key_wo_version") {
+	if d.HasChange("api_key") || d.HasChange("api_key_wo") || d.HasChange("api_key_wo_version") || credutil.ResendSecret(d, "api_key", "api_key_wo") {
 		v := getAPIKey(d)
 		input.APIKey = &v
 	}
-	if d.HasChange("app_key") || d.HasChange("app_key_wo") || d.HasChange("app_key_wo_version") {
+	if d.HasChange("app_key") || d.HasChange("app_key_wo") || d.HasChange("app_key_wo_version") || credutil.ResendSecret(d, "app_key", "app_key_wo") {
 		v := getAppKey(d)
 		input.AppKey = &v
 	}
 
+	if d.HasChange("rotation") {
+		input.Rotation = client.NewRotationUpdate(credutil.ExpandRotation(d.Get("rotation").([]any)))
+	}
+
 	_, err := client.UpdateDatadogAccessCredential(ctx, c, id, input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.RotateIfRequested(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
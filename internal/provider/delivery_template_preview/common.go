@@ -0,0 +1,85 @@
+package delivery_template_preview
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deliverytemplate"
+)
+
+const (
+	idDesc       = "A hash of the template"
+	templateDesc = "The delivery template to render, in the form used by template-type delivery items on `hush_access_policy` (for example `postgresql://${username}:${password}@${host}:${port}/${db}`). In HCL, write each placeholder as `$${key}` so Terraform does not interpolate it."
+	valuesDesc   = "Sample values to substitute, keyed by credential key. Every key the template references must have a value."
+	renderedDesc = "The template rendered against the sample values"
+	keysDesc     = "The credential keys the template references, once each, in order of first appearance"
+)
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"template": {
+			Description: templateDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"values": {
+			Description: valuesDesc,
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"rendered": {
+			Description: renderedDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"keys": {
+			Description: keysDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// dataSourceRead renders the template locally; nothing is sent to the API, so
+// module tests can exercise a template without credentials.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	raw := d.Get("template").(string)
+
+	tmpl, err := deliverytemplate.Parse(raw)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid template: %w", err))
+	}
+
+	values := make(map[string]string)
+	for k, v := range d.Get("values").(map[string]any) {
+		values[k] = v.(string)
+	}
+
+	rendered, err := tmpl.Render(values)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to render template: %w", err))
+	}
+
+	sum := sha256.Sum256([]byte(raw))
+	d.SetId(hex.EncodeToString(sum[:]))
+
+	if err := d.Set("rendered", rendered); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rendered: %w", err))
+	}
+	if err := d.Set("keys", tmpl.Keys()); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set keys: %w", err))
+	}
+
+	return nil
+}
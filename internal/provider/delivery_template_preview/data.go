@@ -0,0 +1,13 @@
+package delivery_template_preview
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to render an access policy delivery template against sample values, for example to assert on a connection string in module tests. The template is rendered locally and no credential is read.",
+		ReadContext: dataSourceRead,
+		Schema:      DataSourceSchema(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
@@ -121,6 +122,8 @@ func DeploymentResourceSchema() map[string]*schema.Schema {
 		},
 	}
 
+	s["deletion_protection"] = deletionprotection.Schema("deployment")
+
 	return s
 }
 
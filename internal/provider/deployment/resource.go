@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const resourceDescription = "Deployment resource for managing Hush Security deployments"
@@ -184,8 +187,16 @@ func expandOidcProviders(d *schema.ResourceData) []client.OidcConfig {
 }
 
 func deploymentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "deployment"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := m.(*client.Client)
 
+	if err := checkNoDependents(ctx, c, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	err := client.DeleteDeployment(ctx, c, d.Id())
 	if err != nil {
 		errResponse, ok := err.(*client.APIError)
@@ -198,3 +209,39 @@ func deploymentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.D
 	d.SetId("")
 	return nil
 }
+
+// checkNoDependents refuses to delete a deployment that access credentials or
+// access policies still name. The API deletes it regardless, leaving them to
+// carry an ID that no longer resolves, so they break without a word. Within one
+// configuration Terraform deletes the dependents first, so this only fires for
+// ones managed elsewhere.
+func checkNoDependents(ctx context.Context, c *client.Client, deploymentID string) error {
+	credentials, err := client.GetAccessCredentialsByDeployment(ctx, c, deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to list the access credentials of deployment '%s': %w", deploymentID, err)
+	}
+	policies, err := client.GetAccessPoliciesByDeployment(ctx, c, deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to list the access policies of deployment '%s': %w", deploymentID, err)
+	}
+
+	// The list filter narrows the listing; it is not relied on. Only resources
+	// that name the deployment themselves block its deletion.
+	var dependents []string
+	for _, credential := range credentials {
+		if slices.Contains(credential.DeploymentIDs, deploymentID) {
+			dependents = append(dependents, fmt.Sprintf("access credential %s (%s)", credential.ID, credential.Name))
+		}
+	}
+	for _, policy := range policies {
+		if slices.Contains(policy.DeploymentIDs, deploymentID) {
+			dependents = append(dependents, fmt.Sprintf("access policy %s (%s)", policy.ID, policy.Name))
+		}
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+	return fmt.Errorf("cannot delete deployment '%s': it is still used by %d resources. "+
+		"Delete them or remove the deployment from them first:\n  - %s",
+		deploymentID, len(dependents), strings.Join(dependents, "\n  - "))
+}
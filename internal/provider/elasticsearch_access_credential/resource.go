@@ -8,11 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Elasticsearch dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -23,7 +24,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -90,17 +91,19 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"host":            credential.Host,
-		"port":            credential.Port,
-		"username":        credential.Username,
-		"tls":             credential.TLS,
-		"tls_ca":          credential.TLSCA,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"host":               credential.Host,
+		"port":               credential.Port,
+		"username":           credential.Username,
+		"tls":                credential.TLS,
+		"tls_ca":             credential.TLSCA,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -150,7 +153,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("tls_ca").(string)
 		input.TLSCA = &v
 	}
-	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") {
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
 		password := writeonly.GetString(d, "password", "password_wo")
 		input.Password = &password
 	}
@@ -160,10 +163,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -5,40 +5,53 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
-	engineNative = "native"
-	engineAiven  = "aiven"
+	engineNative         = "native"
+	engineAiven          = "aiven"
+	engineConfluentCloud = "confluent_cloud"
+	engineMSKIAM         = "msk_iam"
 )
 
 var (
-	validEngines        = []string{engineNative, engineAiven}
+	validEngines        = []string{engineNative, engineAiven, engineConfluentCloud, engineMSKIAM}
 	validSaslMechanisms = []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"}
 )
 
 const (
-	idDesc               = "The unique identifier of the Kafka access credential"
-	nameDesc             = "The name of the Kafka access credential"
-	descriptionDesc      = "The description of the Kafka access credential"
-	deploymentIDsDesc    = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
-	engineDesc           = "The Kafka engine: `native` for a self-managed/standard Kafka cluster, or `aiven` for an Aiven-managed service. Immutable; changing it forces replacement."
-	bootstrapServersDesc = "Comma-separated list of Kafka bootstrap brokers (host:port,host:port). Required when `engine` is `native`."
-	usernameDesc         = "The SASL username for the root Kafka connection. Required when `engine` is `native`."
-	saslMechanismDesc    = "The SASL mechanism for the Kafka connection (PLAIN, SCRAM-SHA-256, or SCRAM-SHA-512). Required when `engine` is `native`."
-	passwordDesc         = "The SASL password for the Kafka connection (required when `engine` is `native`)."
-	passwordWODesc       = "The SASL password for the Kafka connection (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Used when `engine` is `native`."
-	passwordWOVerDesc    = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
-	tlsDesc              = "Whether to use TLS when connecting to the Kafka brokers. Only valid when `engine` is `native`."
-	tlsCADesc            = "The TLS CA certificate for the Kafka connection. Only valid when `engine` is `native`."
-	projectDesc          = "The Aiven project that owns the Kafka service. Required when `engine` is `aiven`."
-	serviceNameDesc      = "The Aiven Kafka service name. Required when `engine` is `aiven`."
-	tokenDesc            = "The Aiven API token used to manage the service (required when `engine` is `aiven`)."
-	tokenWODesc          = "The Aiven API token (write-only). This is a write-only attribute that is more secure than `token` because Terraform will not store this value in the state file. Used when `engine` is `aiven`."
-	tokenWOVerDesc       = "Used to trigger updates for `token_wo`. This value should be changed when the token content changes. Can be any value (e.g., a timestamp, version number, or hash)."
-	typeDesc             = "The type of access credential"
-	kindDesc             = "The kind of access credential"
-	secretStoreIDDesc    = "The ID of the secret store where this credential is saved (optional)"
+	idDesc                = "The unique identifier of the Kafka access credential"
+	nameDesc              = "The name of the Kafka access credential"
+	descriptionDesc       = "The description of the Kafka access credential"
+	deploymentIDsDesc     = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	engineDesc            = "The Kafka engine: `native` for a self-managed/standard Kafka cluster, `aiven` for an Aiven-managed service, `confluent_cloud` for a Confluent Cloud cluster (Hush creates a service account and API key per user), or `msk_iam` for an Amazon MSK cluster with IAM access control (Hush creates an IAM role per user). Immutable; changing it forces replacement."
+	bootstrapServersDesc  = "Comma-separated list of Kafka bootstrap brokers (host:port,host:port). Required when `engine` is `native`."
+	usernameDesc          = "The SASL username for the root Kafka connection. Required when `engine` is `native`."
+	saslMechanismDesc     = "The SASL mechanism for the Kafka connection (PLAIN, SCRAM-SHA-256, or SCRAM-SHA-512). Required when `engine` is `native`."
+	passwordDesc          = "The SASL password for the Kafka connection (required when `engine` is `native`)."
+	passwordWODesc        = "The SASL password for the Kafka connection (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Used when `engine` is `native`."
+	passwordWOVerDesc     = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	tlsDesc               = "Whether to use TLS when connecting to the Kafka brokers. Only valid when `engine` is `native`."
+	tlsCADesc             = "The TLS CA certificate for the Kafka connection. Only valid when `engine` is `native`."
+	projectDesc           = "The Aiven project that owns the Kafka service. Required when `engine` is `aiven`."
+	serviceNameDesc       = "The Aiven Kafka service name. Required when `engine` is `aiven`."
+	tokenDesc             = "The Aiven API token used to manage the service (required when `engine` is `aiven`)."
+	tokenWODesc           = "The Aiven API token (write-only). This is a write-only attribute that is more secure than `token` because Terraform will not store this value in the state file. Used when `engine` is `aiven`."
+	tokenWOVerDesc        = "Used to trigger updates for `token_wo`. This value should be changed when the token content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	environmentIDDesc     = "The Confluent Cloud environment that owns the cluster (`env-...`). Required when `engine` is `confluent_cloud`."
+	clusterIDDesc         = "The Confluent Cloud Kafka cluster ID (`lkc-...`). Required when `engine` is `confluent_cloud`."
+	cloudAPIKeyDesc       = "The Confluent Cloud API key Hush uses to manage service accounts, API keys and role bindings. It needs the OrganizationAdmin or AccountAdmin role. Required when `engine` is `confluent_cloud`."
+	cloudAPISecretDesc    = "The secret of the Confluent Cloud API key (required when `engine` is `confluent_cloud`)."
+	cloudAPISecretWODesc  = "The secret of the Confluent Cloud API key (write-only). This is a write-only attribute that is more secure than `cloud_api_secret` because Terraform will not store this value in the state file. Used when `engine` is `confluent_cloud`."
+	cloudAPISecretWOVDesc = "Used to trigger updates for `cloud_api_secret_wo`. This value should be changed when the secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	clusterARNDesc        = "The ARN of the Amazon MSK cluster. Required when `engine` is `msk_iam`."
+	regionDesc            = "The AWS region of the Amazon MSK cluster. Required when `engine` is `msk_iam`."
+	roleARNDesc           = "The ARN of the IAM role Hush assumes to create the per-user IAM roles and policies for the cluster. Required when `engine` is `msk_iam`."
+	typeDesc              = "The type of access credential"
+	kindDesc              = "The kind of access credential"
+	secretStoreIDDesc     = "The ID of the secret store where this credential is saved (optional)"
 )
 
 func ResourceSchema() map[string]*schema.Schema {
@@ -73,7 +86,7 @@ func ResourceSchema() map[string]*schema.Schema {
 		},
 	}
 	s["secret_store_id"] = &schema.Schema{
-		Description:  secretStoreIDDesc,
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
@@ -170,6 +183,66 @@ func ResourceSchema() map[string]*schema.Schema {
 		Optional:     true,
 		RequiredWith: []string{"token_wo"},
 	}
+	// Confluent Cloud-engine fields.
+	s["environment_id"] = &schema.Schema{
+		Description:  environmentIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^env-`), "environment_id must start with 'env-'"),
+	}
+	s["cluster_id"] = &schema.Schema{
+		Description:  clusterIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^lkc-`), "cluster_id must start with 'lkc-'"),
+	}
+	s["cloud_api_key"] = &schema.Schema{
+		Description: cloudAPIKeyDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["cloud_api_secret"] = &schema.Schema{
+		Description:   cloudAPISecretDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"cloud_api_secret_wo"},
+	}
+	s["cloud_api_secret_wo"] = &schema.Schema{
+		Description:   cloudAPISecretWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"cloud_api_secret"},
+		RequiredWith:  []string{"cloud_api_secret_wo_version"},
+	}
+	s["cloud_api_secret_wo_version"] = &schema.Schema{
+		Description:  cloudAPISecretWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"cloud_api_secret_wo"},
+	}
+	// MSK IAM-engine fields.
+	s["cluster_arn"] = &schema.Schema{
+		Description:  clusterARNDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[a-z-]*:kafka:`), "cluster_arn must be an Amazon MSK cluster ARN"),
+	}
+	s["region"] = &schema.Schema{
+		Description: regionDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["role_arn"] = &schema.Schema{
+		Description:  roleARNDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/`), "role_arn must be an IAM role ARN"),
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
 
 	return s
 }
@@ -239,6 +312,36 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"environment_id": {
+			Description: environmentIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster_id": {
+			Description: clusterIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cloud_api_key": {
+			Description: cloudAPIKeyDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster_arn": {
+			Description: clusterARNDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"region": {
+			Description: regionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"role_arn": {
+			Description: roleARNDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"type": {
 			Description: typeDesc,
 			Type:        schema.TypeString,
@@ -254,5 +357,7 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -11,11 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Kafka dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -26,13 +28,13 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 // customizeDiff rejects deployment_ids changes after creation and enforces the
 // per-engine field rules (mirroring midgard's _validate_engine_fields): every
 // engine requires all its fields except the optional tls/tls_ca, and forbids the
-// other engine's fields.
+// other engines' fields.
 func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	if err := credutil.ForbidDeploymentIDsChange(ctx, d, meta); err != nil {
 		return err
@@ -40,6 +42,15 @@ func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error
 	return validateEngineFields(d)
 }
 
+// Field groups owned by a single engine. Every field but the native engine's
+// optional tls/tls_ca is required by its engine.
+var (
+	nativeFields         = []string{"bootstrap_servers", "username", "sasl_mechanism", "tls", "tls_ca", "password"}
+	aivenFields          = []string{"project", "service_name", "token"}
+	confluentCloudFields = []string{"environment_id", "cluster_id", "cloud_api_key", "cloud_api_secret"}
+	mskIAMFields         = []string{"cluster_arn", "region", "role_arn"}
+)
+
 func validateEngineFields(d *schema.ResourceDiff) error {
 	engine := d.Get("engine").(string)
 
@@ -47,10 +58,16 @@ func validateEngineFields(d *schema.ResourceDiff) error {
 	switch engine {
 	case engineNative:
 		required = []string{"bootstrap_servers", "username", "sasl_mechanism", "password"}
-		forbidden = []string{"project", "service_name", "token"}
+		forbidden = slices.Concat(aivenFields, confluentCloudFields, mskIAMFields)
 	case engineAiven:
-		required = []string{"project", "service_name", "token"}
-		forbidden = []string{"bootstrap_servers", "username", "sasl_mechanism", "tls", "tls_ca", "password"}
+		required = aivenFields
+		forbidden = slices.Concat(nativeFields, confluentCloudFields, mskIAMFields)
+	case engineConfluentCloud:
+		required = confluentCloudFields
+		forbidden = slices.Concat(nativeFields, aivenFields, mskIAMFields)
+	case engineMSKIAM:
+		required = mskIAMFields
+		forbidden = slices.Concat(nativeFields, aivenFields, confluentCloudFields)
 	default:
 		return nil
 	}
@@ -86,6 +103,8 @@ func attrSet(d *schema.ResourceDiff, attr string) bool {
 		return rawSet(d, "password") || rawSet(d, "password_wo")
 	case "token":
 		return rawSet(d, "token") || rawSet(d, "token_wo")
+	case "cloud_api_secret":
+		return rawSet(d, "cloud_api_secret") || rawSet(d, "cloud_api_secret_wo")
 	default:
 		return rawSet(d, attr)
 	}
@@ -145,6 +164,15 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		input.Project = d.Get("project").(string)
 		input.ServiceName = d.Get("service_name").(string)
 		input.Token = writeonly.GetString(d, "token", "token_wo")
+	case engineConfluentCloud:
+		input.EnvironmentID = d.Get("environment_id").(string)
+		input.ClusterID = d.Get("cluster_id").(string)
+		input.CloudAPIKey = d.Get("cloud_api_key").(string)
+		input.CloudAPISecret = writeonly.GetString(d, "cloud_api_secret", "cloud_api_secret_wo")
+	case engineMSKIAM:
+		input.ClusterARN = d.Get("cluster_arn").(string)
+		input.Region = d.Get("region").(string)
+		input.RoleARN = d.Get("role_arn").(string)
 	}
 
 	credential, err := client.CreateKafkaAccessCredential(ctx, c, input)
@@ -183,20 +211,28 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":              credential.Name,
-		"description":       credential.Description,
-		"deployment_ids":    credential.DeploymentIDs,
-		"engine":            credential.Engine,
-		"bootstrap_servers": credential.BootstrapServers,
-		"username":          credential.Username,
-		"sasl_mechanism":    credential.SaslMechanism,
-		"tls":               credential.TLS,
-		"tls_ca":            credential.TLSCA,
-		"project":           credential.Project,
-		"service_name":      credential.ServiceName,
-		"type":              string(credential.Type),
-		"kind":              credential.Kind,
-		"secret_store_id":   credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"engine":             credential.Engine,
+		"bootstrap_servers":  credential.BootstrapServers,
+		"username":           credential.Username,
+		"sasl_mechanism":     credential.SaslMechanism,
+		"tls":                credential.TLS,
+		"tls_ca":             credential.TLSCA,
+		"project":            credential.Project,
+		"service_name":       credential.ServiceName,
+		"environment_id":     credential.EnvironmentID,
+		"cluster_id":         credential.ClusterID,
+		"cloud_api_key":      credential.CloudAPIKey,
+		"cluster_arn":        credential.ClusterARN,
+		"region":             credential.Region,
+		"role_arn":           credential.RoleARN,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -254,24 +290,60 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("service_name").(string)
 		input.ServiceName = &v
 	}
-	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") {
+	if d.HasChange("environment_id") {
+		v := d.Get("environment_id").(string)
+		input.EnvironmentID = &v
+	}
+	if d.HasChange("cluster_id") {
+		v := d.Get("cluster_id").(string)
+		input.ClusterID = &v
+	}
+	if d.HasChange("cloud_api_key") {
+		v := d.Get("cloud_api_key").(string)
+		input.CloudAPIKey = &v
+	}
+	if d.HasChange("cluster_arn") {
+		v := d.Get("cluster_arn").(string)
+		input.ClusterARN = &v
+	}
+	if d.HasChange("region") {
+		v := d.Get("region").(string)
+		input.Region = &v
+	}
+	if d.HasChange("role_arn") {
+		v := d.Get("role_arn").(string)
+		input.RoleARN = &v
+	}
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
 		password := writeonly.GetString(d, "password", "password_wo")
 		input.Password = &password
 	}
-	if d.HasChange("token") || d.HasChange("token_wo") || d.HasChange("token_wo_version") {
+	if d.HasChange("token") || d.HasChange("token_wo") || d.HasChange("token_wo_version") || credutil.ResendSecret(d, "token", "token_wo") {
 		token := writeonly.GetString(d, "token", "token_wo")
 		input.Token = &token
 	}
+	if d.HasChange("cloud_api_secret") || d.HasChange("cloud_api_secret_wo") || d.HasChange("cloud_api_secret_wo_version") || credutil.ResendSecret(d, "cloud_api_secret", "cloud_api_secret_wo") {
+		secret := writeonly.GetString(d, "cloud_api_secret", "cloud_api_secret_wo")
+		input.CloudAPISecret = &secret
+	}
 
 	_, err := client.UpdateKafkaAccessCredential(ctx, c, id, input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -10,19 +10,26 @@ const (
 	idDesc             = "The unique identifier of the Kafka access privilege"
 	nameDesc           = "The name of the Kafka access privilege"
 	descriptionDesc    = "The description of the Kafka access privilege"
-	aclsDesc           = "The Kafka ACL entries granted by this privilege"
+	aclsDesc           = "The Kafka ACL entries granted by this privilege. On `native` and `aiven` credentials they are applied as Kafka ACLs. On `confluent_cloud` credentials they are applied as Confluent ACLs or RBAC role bindings, depending on `confluent_authorization`. On `msk_iam` credentials each entry becomes an IAM policy statement on the matching `kafka-cluster` actions (`DENY` entries become `Deny` statements), and `host` must be `*`."
 	resourceTypeDesc   = "The Kafka resource type the ACL applies to (e.g., Topic, Group, Cluster, TransactionalId)"
 	resourceNameDesc   = "The name of the Kafka resource the ACL applies to (use `*` for all)"
 	patternTypeDesc    = "How resource_name is matched: LITERAL (exact) or PREFIXED (name prefix)"
 	operationDesc      = "The Kafka operation the ACL applies to (e.g., Read, Write, Create, All)"
 	permissionTypeDesc = "Whether the ACL grants (ALLOW) or denies (DENY) the operation"
 	hostDesc           = "The host the ACL applies to (defaults to `*`, all hosts)"
+	confluentAuthDesc  = "How the ACL entries are applied on `confluent_cloud` credentials: `acls` creates Confluent ACLs for the user's service account, `rbac` maps each entry onto the closest role binding (e.g., Read on a Topic becomes DeveloperRead, Write becomes DeveloperWrite, All becomes ResourceOwner). RBAC has no deny or host restrictions, so `rbac` requires every entry to be `ALLOW` on host `*`. Ignored by other engines."
 	typeDesc           = "The type of access privilege"
 )
 
+const (
+	confluentAuthACLs = "acls"
+	confluentAuthRBAC = "rbac"
+)
+
 var (
 	validPatternTypes    = []string{"LITERAL", "PREFIXED"}
 	validPermissionTypes = []string{"ALLOW", "DENY"}
+	validConfluentAuths  = []string{confluentAuthACLs, confluentAuthRBAC}
 )
 
 func ResourceSchema() map[string]*schema.Schema {
@@ -91,6 +98,13 @@ func ResourceSchema() map[string]*schema.Schema {
 			},
 		},
 	}
+	s["confluent_authorization"] = &schema.Schema{
+		Description:  confluentAuthDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      confluentAuthACLs,
+		ValidateFunc: validation.StringInSlice(validConfluentAuths, false),
+	}
 
 	return s
 }
@@ -151,6 +165,11 @@ func DataSourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"confluent_authorization": {
+			Description: confluentAuthDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"type": {
 			Description: typeDesc,
 			Type:        schema.TypeString,
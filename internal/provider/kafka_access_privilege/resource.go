@@ -2,6 +2,7 @@ package kafka_access_privilege
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +17,7 @@ func Resource() *schema.Resource {
 		ReadContext:   resourceRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: validateACLs,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,11 +25,33 @@ func Resource() *schema.Resource {
 	}
 }
 
+// validateACLs rejects entries Confluent RBAC cannot express when
+// confluent_authorization is rbac: role bindings only grant, on every host.
+func validateACLs(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Get("confluent_authorization").(string) != confluentAuthRBAC {
+		return nil
+	}
+	for i, v := range d.Get("acls").([]any) {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m["permission_type"].(string) == "DENY" {
+			return fmt.Errorf("acls.%d: DENY cannot be expressed with confluent_authorization \"rbac\"", i)
+		}
+		if host := m["host"].(string); host != "" && host != "*" {
+			return fmt.Errorf("acls.%d: host must be \"*\" with confluent_authorization \"rbac\"", i)
+		}
+	}
+	return nil
+}
+
 func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*client.Client)
 
 	input := &client.CreateKafkaAccessPrivilegeInput{
-		Name: d.Get("name").(string),
+		Name:                   d.Get("name").(string),
+		ConfluentAuthorization: d.Get("confluent_authorization").(string),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -71,11 +95,18 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 
 	d.SetId(privilege.ID)
 
+	// Privileges created before confluent_authorization existed use ACLs.
+	confluentAuth := privilege.ConfluentAuthorization
+	if confluentAuth == "" {
+		confluentAuth = confluentAuthACLs
+	}
+
 	fields := map[string]any{
-		"name":        privilege.Name,
-		"description": privilege.Description,
-		"acls":        flattenACLs(privilege.Acls),
-		"type":        privilege.Type,
+		"name":                    privilege.Name,
+		"description":             privilege.Description,
+		"acls":                    flattenACLs(privilege.Acls),
+		"confluent_authorization": confluentAuth,
+		"type":                    privilege.Type,
 	}
 
 	for field, value := range fields {
@@ -105,6 +136,10 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		acls := expandACLs(d.Get("acls").([]any))
 		input.Acls = &acls
 	}
+	if d.HasChange("confluent_authorization") {
+		v := d.Get("confluent_authorization").(string)
+		input.ConfluentAuthorization = &v
+	}
 
 	_, err := client.UpdateKafkaAccessPrivilege(ctx, c, id, input)
 	if err != nil {
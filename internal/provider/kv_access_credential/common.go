@@ -4,7 +4,10 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
@@ -12,7 +15,12 @@ const (
 	nameDesc          = "The name of the KV access credential"
 	descriptionDesc   = "The description of the KV access credential"
 	deploymentIDsDesc = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
-	itemsDesc         = "List of key-value pairs for the credential"
+	itemsDesc         = "List of key-value pairs for the credential. Exactly one of items, items_json and items_wo must be set"
+	itemsJSONDesc     = "The key-value pairs as a JSON object of string values, e.g. `jsonencode(var.settings)` or `jsonencode(yamldecode(file(\"settings.yaml\")))`. Exactly one of items, items_json and items_wo must be set"
+	itemsWODesc       = "Write-only counterpart of items_json, kept out of state. Requires items_wo_version"
+	itemsWOVerDesc    = "Any value. items_wo is only sent when this changes, and then every item is sent"
+	fingerprintsDesc  = "HMAC-SHA256 fingerprint of each item, by key, keyed with item_fingerprint_salt. An apply sends only the items whose fingerprint changed and deletes the keys no longer configured"
+	saltDesc          = "Random key for item_fingerprints, generated once per credential so that the fingerprints cannot be matched against precomputed hashes of likely values"
 	keyDesc           = "The key name for the environment variable"
 	valueDesc         = "The value for the key-value pair"
 	keysDesc          = "List of keys available in this credential (computed)"
@@ -52,26 +60,24 @@ func KVAccessCredentialResourceSchema() map[string]*schema.Schema {
 		},
 	}
 	s["secret_store_id"] = &schema.Schema{
-		Description:  secretStoreIDDesc,
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
 	}
 	s["items"] = &schema.Schema{
-		Description: itemsDesc,
-		Type:        schema.TypeList,
-		Required:    true,
-		MinItems:    1,
+		Description:  itemsDesc,
+		Type:         schema.TypeList,
+		Optional:     true,
+		MinItems:     1,
+		ExactlyOneOf: []string{"items", "items_json", "items_wo"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"key": {
-					Description: keyDesc,
-					Type:        schema.TypeString,
-					Required:    true,
-					ValidateFunc: validation.All(
-						validation.StringLenBetween(1, 255),
-						validation.StringDoesNotMatch(regexp.MustCompile(`^_?_?hush`), "Keys cannot start with '_hush' or '__hush'"),
-					),
+					Description:  keyDesc,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateItemKey,
 				},
 				"value": {
 					Description: valueDesc,
@@ -83,6 +89,51 @@ func KVAccessCredentialResourceSchema() map[string]*schema.Schema {
 		},
 	}
 
+	s["items_json"] = &schema.Schema{
+		Description:      itemsJSONDesc,
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ExactlyOneOf:     []string{"items", "items_json", "items_wo"},
+		ValidateFunc:     validateItemsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+	}
+	s["items_wo"] = &schema.Schema{
+		Description:  itemsWODesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		WriteOnly:    true,
+		ExactlyOneOf: []string{"items", "items_json", "items_wo"},
+		ValidateFunc: validateItemsJSON,
+		RequiredWith: []string{"items_wo_version"},
+	}
+	s["items_wo_version"] = &schema.Schema{
+		Description:  itemsWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"items_wo"},
+	}
+	s["item_fingerprints"] = &schema.Schema{
+		Description: fingerprintsDesc,
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Sensitive:   true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	s["item_fingerprint_salt"] = &schema.Schema{
+		Description: saltDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+	}
+
+	s["rotation"] = credutil.RotationResourceSchema()
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
 	return s
 }
 
@@ -129,5 +180,8 @@ func KVAccessCredentialDataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"rotation":           credutil.RotationDataSourceSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
 	}
 }
@@ -0,0 +1,190 @@
+package kv_access_credential
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+var validateItemKey = validation.All(
+	validation.StringLenBetween(1, 255),
+	validation.StringDoesNotMatch(regexp.MustCompile(`^_?_?hush`), "Keys cannot start with '_hush' or '__hush'"),
+)
+
+// itemsSource is implemented by both *schema.ResourceData and *schema.ResourceDiff.
+type itemsSource interface {
+	Get(key string) any
+	GetOk(key string) (any, bool)
+	GetRawConfig() cty.Value
+}
+
+// parseItemsJSON decodes items_json or items_wo: a JSON object mapping each
+// key to its string value, as produced by jsonencode.
+func parseItemsJSON(s string) (map[string]string, error) {
+	var items map[string]string
+	if err := json.Unmarshal([]byte(s), &items); err != nil {
+		return nil, fmt.Errorf("must be a JSON object of string values, e.g. jsonencode({ KEY = \"value\" }): %w", err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("must contain at least one item")
+	}
+	for key := range items {
+		if _, errs := validateItemKey(key, fmt.Sprintf("key %q", key)); len(errs) > 0 {
+			return nil, errs[0]
+		}
+	}
+	return items, nil
+}
+
+func validateItemsJSON(v any, k string) ([]string, []error) {
+	if _, err := parseItemsJSON(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s %w", k, err)}
+	}
+	return nil, nil
+}
+
+// configuredItems returns the items of whichever of items, items_json and
+// items_wo is configured.
+func configuredItems(d itemsSource) (map[string]string, error) {
+	if list := d.Get("items").([]any); len(list) > 0 {
+		items := make(map[string]string, len(list))
+		for _, item := range list {
+			m, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			key := m["key"].(string)
+			if _, dup := items[key]; dup {
+				return nil, fmt.Errorf("items: key %q is set more than once", key)
+			}
+			items[key] = m["value"].(string)
+		}
+		return items, nil
+	}
+	if v := writeonly.GetString(d, "items_json", "items_wo"); v != "" {
+		return parseItemsJSON(v)
+	}
+	return map[string]string{}, nil
+}
+
+// itemsKnown reports whether the configured items are known at plan time.
+func itemsKnown(d itemsSource) bool {
+	rc := d.GetRawConfig()
+	if rc.IsNull() {
+		return true
+	}
+	for _, attr := range []string{"items", "items_json", "items_wo"} {
+		if !rc.GetAttr(attr).IsWhollyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// itemFingerprint hashes an item so that a change to its value can be told
+// from state without storing the value there. It is keyed with the
+// credential's salt, so a guessed value cannot be checked against it without
+// the salt, nor against the fingerprints of other credentials.
+func itemFingerprint(salt, key, value string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(key + "\x00" + value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func itemFingerprints(salt string, items map[string]string) map[string]any {
+	fingerprints := make(map[string]any, len(items))
+	for key, value := range items {
+		fingerprints[key] = itemFingerprint(salt, key, value)
+	}
+	return fingerprints
+}
+
+// fingerprintSalt returns the credential's item_fingerprint_salt, generating
+// one when it has none yet: on create, and on the first update of an
+// imported credential.
+func fingerprintSalt(d *schema.ResourceData) (string, error) {
+	if salt := d.Get("item_fingerprint_salt").(string); salt != "" {
+		return salt, nil
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate item_fingerprint_salt: %w", err)
+	}
+	salt := hex.EncodeToString(b)
+	if err := d.Set("item_fingerprint_salt", salt); err != nil {
+		return "", err
+	}
+	return salt, nil
+}
+
+// itemList returns items as the API's list, ordered by key.
+func itemList(items map[string]string) []client.KVItem {
+	list := make([]client.KVItem, 0, len(items))
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		list = append(list, client.KVItem{Key: key, Value: items[key]})
+	}
+	return list
+}
+
+// diffItems compares items with the fingerprints of the items last sent,
+// returning the items to upsert and the keys to delete, both ordered by key.
+func diffItems(salt string, fingerprints map[string]any, items map[string]string) ([]client.KVItem, []string) {
+	var upserts []client.KVItem
+	for _, item := range itemList(items) {
+		if fingerprints[item.Key] != itemFingerprint(salt, item.Key, item.Value) {
+			upserts = append(upserts, item)
+		}
+	}
+	var deletes []string
+	for _, key := range slices.Sorted(maps.Keys(fingerprints)) {
+		if _, ok := items[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	return upserts, deletes
+}
+
+// planItemFingerprints plans item_fingerprints from the configured items, so
+// that a plan shows which keys an apply will send. items_wo is only sent when
+// items_wo_version changes, so its value is not compared until then. The
+// fingerprints of a credential without a salt yet are only known once the
+// apply has generated one.
+func planItemFingerprints(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	rc := d.GetRawConfig()
+	usesWO := !rc.IsNull() && !rc.GetAttr("items_wo").IsNull()
+	if usesWO && d.Id() != "" && !d.HasChange("items_wo_version") {
+		return nil
+	}
+	salt := d.Get("item_fingerprint_salt").(string)
+	if salt == "" {
+		if err := d.SetNewComputed("item_fingerprint_salt"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("item_fingerprints")
+	}
+	if usesWO || !itemsKnown(d) {
+		return d.SetNewComputed("item_fingerprints")
+	}
+	items, err := configuredItems(d)
+	if err != nil {
+		return err
+	}
+	want := itemFingerprints(salt, items)
+	if maps.Equal(d.Get("item_fingerprints").(map[string]any), want) {
+		return nil
+	}
+	return d.SetNew("item_fingerprints", want)
+}
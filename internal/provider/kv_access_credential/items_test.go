@@ -0,0 +1,145 @@
+package kv_access_credential
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestParseItemsJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "object", input: `{"HOST":"db","PORT":"5432"}`, want: map[string]string{"HOST": "db", "PORT": "5432"}},
+		{name: "not an object", input: `["HOST"]`, wantErr: true},
+		{name: "non-string value", input: `{"PORT":5432}`, wantErr: true},
+		{name: "empty object", input: `{}`, wantErr: true},
+		{name: "reserved key", input: `{"_hush_id":"x"}`, wantErr: true},
+		{name: "empty key", input: `{"":"x"}`, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseItemsJSON(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tc.wantErr)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestConfiguredItems(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]any
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "items blocks",
+			raw: map[string]any{"items": []any{
+				map[string]any{"key": "HOST", "value": "db"},
+				map[string]any{"key": "PORT", "value": "5432"},
+			}},
+			want: map[string]string{"HOST": "db", "PORT": "5432"},
+		},
+		{
+			name: "duplicate key",
+			raw: map[string]any{"items": []any{
+				map[string]any{"key": "HOST", "value": "db"},
+				map[string]any{"key": "HOST", "value": "replica"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "items_json",
+			raw:  map[string]any{"items_json": `{"HOST":"db"}`},
+			want: map[string]string{"HOST": "db"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, KVAccessCredentialResourceSchema(), tc.raw)
+			got, err := configuredItems(d)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tc.wantErr)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffItems(t *testing.T) {
+	const salt = "salt"
+	sent := map[string]any{
+		"HOST": itemFingerprint(salt, "HOST", "db"),
+		"PORT": itemFingerprint(salt, "PORT", "5432"),
+		"USER": itemFingerprint(salt, "USER", "app"),
+	}
+	items := map[string]string{
+		"HOST": "db",
+		"PORT": "6432",
+		"NAME": "orders",
+	}
+
+	upserts, deletes := diffItems(salt, sent, items)
+
+	wantUpserts := []client.KVItem{{Key: "NAME", Value: "orders"}, {Key: "PORT", Value: "6432"}}
+	if !reflect.DeepEqual(upserts, wantUpserts) {
+		t.Errorf("expected upserts %v, got %v", wantUpserts, upserts)
+	}
+	if want := []string{"USER"}; !reflect.DeepEqual(deletes, want) {
+		t.Errorf("expected deletes %v, got %v", want, deletes)
+	}
+
+	upserts, deletes = diffItems(salt, itemFingerprints(salt, items), items)
+	if len(upserts) != 0 || len(deletes) != 0 {
+		t.Errorf("expected no changes for unchanged items, got upserts %v and deletes %v", upserts, deletes)
+	}
+}
+
+func TestItemFingerprint(t *testing.T) {
+	if itemFingerprint("salt", "A", "BC") == itemFingerprint("salt", "AB", "C") {
+		t.Error("expected the key and value to be hashed separately")
+	}
+	if itemFingerprint("salt", "A", "x") == itemFingerprint("salt", "A", "y") {
+		t.Error("expected different values to have different fingerprints")
+	}
+	if itemFingerprint("salt-1", "A", "x") == itemFingerprint("salt-2", "A", "x") {
+		t.Error("expected credentials with different salts to have different fingerprints")
+	}
+}
+
+func TestFingerprintSalt(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, KVAccessCredentialResourceSchema(), map[string]any{})
+	salt, err := fingerprintSalt(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(salt) != 64 {
+		t.Fatalf("expected a 32-byte hex salt, got %q", salt)
+	}
+	again, err := fingerprintSalt(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again != salt {
+		t.Errorf("expected the generated salt to be kept, got %q then %q", salt, again)
+	}
+
+	other := schema.TestResourceDataRaw(t, KVAccessCredentialResourceSchema(), map[string]any{})
+	if otherSalt, _ := fingerprintSalt(other); otherSalt == salt {
+		t.Error("expected each credential to get its own salt")
+	}
+}
@@ -2,27 +2,31 @@ package kv_access_credential
 
 import (
 	"context"
+	"maps"
 	"net/http"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage key-value access credentials in the Hush Security platform. KV credentials store multiple key-value pairs that can be delivered as separate environment variables to specified deployments.",
 		CreateContext: kvAccessCredentialCreate,
 		ReadContext:   kvAccessCredentialRead,
 		UpdateContext: kvAccessCredentialUpdate,
 		DeleteContext: kvAccessCredentialDelete,
-		CustomizeDiff: credutil.ForbidDeploymentIDsChange,
+		CustomizeDiff: customdiff.All(credutil.ForbidDeploymentIDsChange, planItemFingerprints),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: KVAccessCredentialResourceSchema(),
-	}
+	})
 }
 
 func kvAccessCredentialCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -36,16 +40,13 @@ func kvAccessCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	items := make([]client.KVItem, 0)
-	if v, ok := d.GetOk("items"); ok {
-		itemsList := v.([]any)
-		for _, item := range itemsList {
-			itemMap := item.(map[string]any)
-			items = append(items, client.KVItem{
-				Key:   itemMap["key"].(string),
-				Value: itemMap["value"].(string),
-			})
-		}
+	items, err := configuredItems(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	salt, err := fingerprintSalt(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	input := &client.CreateKVAccessCredentialInput{
@@ -53,7 +54,8 @@ func kvAccessCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 		Description:   d.Get("description").(string),
 		DeploymentIDs: deploymentIDs,
 		SecretStoreID: d.Get("secret_store_id").(string),
-		Items:         items,
+		Items:         itemList(items),
+		Rotation:      credutil.ExpandRotation(d.Get("rotation").([]any)),
 	}
 
 	credential, err := client.CreateKVAccessCredential(ctx, c, input)
@@ -63,6 +65,10 @@ func kvAccessCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(credential.ID)
 
+	if err := d.Set("item_fingerprints", itemFingerprints(salt, items)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return kvAccessCredentialRead(ctx, d, meta)
 }
 
@@ -104,12 +110,30 @@ func kvAccessCredentialRead(ctx context.Context, d *schema.ResourceData, meta an
 	if err := d.Set("keys", credential.Keys); err != nil {
 		return diag.FromErr(err)
 	}
+	// Drop the fingerprints of keys deleted outside Terraform, so that the
+	// next plan puts them back.
+	fingerprints := d.Get("item_fingerprints").(map[string]any)
+	maps.DeleteFunc(fingerprints, func(key string, _ any) bool {
+		return !slices.Contains(credential.Keys, key)
+	})
+	if err := d.Set("item_fingerprints", fingerprints); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("type", string(credential.Type)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("secret_store_id", credential.SecretStoreID); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("secret_store_sync", credutil.FlattenSecretStoreSync(credential.SecretStoreSync)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret_fingerprint", credential.SecretFingerprint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rotation", credutil.FlattenRotation(credential.Rotation, d)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -135,18 +159,26 @@ func kvAccessCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.SecretStoreID = client.NewSecretStoreIDUpdate(secretStoreID)
 	}
 
-	if d.HasChange("items") {
-		items := make([]client.KVItem, 0)
-		if v, ok := d.GetOk("items"); ok {
-			for _, item := range v.([]any) {
-				itemMap := item.(map[string]any)
-				items = append(items, client.KVItem{
-					Key:   itemMap["key"].(string),
-					Value: itemMap["value"].(string),
-				})
-			}
+	var items map[string]string
+	var salt string
+	resendAll := d.HasChange("items_wo_version") || credutil.SecretDrifted(d)
+	if d.HasChange("item_fingerprints") || resendAll {
+		var err error
+		if items, err = configuredItems(d); err != nil {
+			return diag.FromErr(err)
+		}
+		if salt, err = fingerprintSalt(d); err != nil {
+			return diag.FromErr(err)
+		}
+		sent, _ := d.GetChange("item_fingerprints")
+		input.UpsertItems, input.DeleteKeys = diffItems(salt, sent.(map[string]any), items)
+		if resendAll {
+			input.UpsertItems = itemList(items)
 		}
-		input.Items = items
+	}
+
+	if d.HasChange("rotation") {
+		input.Rotation = client.NewRotationUpdate(credutil.ExpandRotation(d.Get("rotation").([]any)))
 	}
 
 	_, err := client.UpdateKVAccessCredential(ctx, c, id, input)
@@ -154,10 +186,28 @@ func kvAccessCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if items != nil {
+		if err := d.Set("item_fingerprints", itemFingerprints(salt, items)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := credutil.RotateIfRequested(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return kvAccessCredentialRead(ctx, d, meta)
 }
 
 func kvAccessCredentialDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
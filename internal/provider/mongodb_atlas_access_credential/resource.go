@@ -10,11 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage MongoDB Atlas dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -25,7 +26,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 // validateAtlasAuth enforces the backend rule that exactly one authentication
@@ -114,17 +115,19 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"group_id":        credential.GroupID,
-		"db_name":         credential.DBName,
-		"host":            credential.Host,
-		"client_id":       credential.ClientID,
-		"public_key":      credential.PublicKey,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"group_id":           credential.GroupID,
+		"db_name":            credential.DBName,
+		"host":               credential.Host,
+		"client_id":          credential.ClientID,
+		"public_key":         credential.PublicKey,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -170,7 +173,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("client_id").(string)
 		input.ClientID = &v
 	}
-	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") {
+	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") || credutil.ResendSecret(d, "client_secret", "client_secret_wo") {
 		v := writeonly.GetString(d, "client_secret", "client_secret_wo")
 		input.ClientSecret = &v
 	}
@@ -178,7 +181,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("public_key").(string)
 		input.PublicKey = &v
 	}
-	if d.HasChange("private_key") || d.HasChange("private_key_wo") || d.HasChange("private_key_wo_version") {
+	if d.HasChange("private_key") || d.HasChange("private_key_wo") || d.HasChange("private_key_wo_version") || credutil.ResendSecret(d, "private_key", "private_key_wo") {
 		v := writeonly.GetString(d, "private_key", "private_key_wo")
 		input.PrivateKey = &v
 	}
@@ -188,10 +191,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -0,0 +1,242 @@
+package mssql_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+const (
+	idDesc            = "The unique identifier of the Microsoft SQL Server access credential"
+	nameDesc          = "The name of the Microsoft SQL Server access credential"
+	descriptionDesc   = "The description of the Microsoft SQL Server access credential"
+	deploymentIDsDesc = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	dbNameDesc        = "The name of the database the short-lived users are created in"
+	hostDesc          = "The hostname or IP address of the SQL Server or Azure SQL server, for example `myserver.database.windows.net`"
+	portDesc          = "The port number of the SQL Server (default: 1433). Ignored when `instance` is set, since the SQL Server Browser service resolves the instance's port"
+	instanceDesc      = "The name of the SQL Server named instance to connect to, for example `SQLEXPRESS`. Leave empty for the default instance and for Azure SQL"
+	encryptDesc       = "Whether the connection is encrypted: `disable`, `false` (only the login is encrypted), `true` or `strict` (TDS 8.0). Defaults to `true`"
+	trustCertDesc     = "Whether to accept the server's TLS certificate without verifying it. Only use this for servers with self-signed certificates"
+	authMethodDesc    = "How Hush authenticates to the server: `sql` for SQL Server authentication with `username` and `password`, or `entra_id` for a Microsoft Entra ID service principal, with the application (client) ID as `username`, a client secret as `password` and `tenant_id` set (default: sql)"
+	tenantIDDesc      = "The Microsoft Entra ID tenant of the service principal. Required when `auth_method` is `entra_id`"
+	usernameDesc      = "The admin login, or the service principal's application (client) ID when `auth_method` is `entra_id`"
+	passwordDesc      = "The admin login's password, or the service principal's client secret when `auth_method` is `entra_id`"
+	passwordWODesc    = "The admin login's password, or the service principal's client secret when `auth_method` is `entra_id` (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Either `password` or `password_wo` must be specified."
+	passwordWOVerDesc = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	typeDesc          = "The type of access credential"
+	kindDesc          = "The kind of access credential"
+	secretStoreIDDesc = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["db_name"] = &schema.Schema{
+		Description: dbNameDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s["host"] = &schema.Schema{
+		Description: hostDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s["port"] = &schema.Schema{
+		Description: portDesc,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     1433,
+	}
+	s["instance"] = &schema.Schema{
+		Description: instanceDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["encrypt"] = &schema.Schema{
+		Description:  encryptDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "true",
+		ValidateFunc: validation.StringInSlice([]string{"disable", "false", "true", "strict"}, false),
+	}
+	s["trust_server_certificate"] = &schema.Schema{
+		Description: trustCertDesc,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["auth_method"] = &schema.Schema{
+		Description:  authMethodDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      client.MSSQLAuthMethodSQL,
+		ValidateFunc: validation.StringInSlice([]string{client.MSSQLAuthMethodSQL, client.MSSQLAuthMethodEntraID}, false),
+	}
+	s["tenant_id"] = &schema.Schema{
+		Description:  tenantIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	s["username"] = &schema.Schema{
+		Description: usernameDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s["password"] = &schema.Schema{
+		Description:   passwordDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"password_wo"},
+		ExactlyOneOf:  []string{"password", "password_wo"},
+	}
+	s["password_wo"] = &schema.Schema{
+		Description:   passwordWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"password"},
+		ExactlyOneOf:  []string{"password", "password_wo"},
+		RequiredWith:  []string{"password_wo_version"},
+	}
+	s["password_wo_version"] = &schema.Schema{
+		Description:  passwordWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"db_name": {
+			Description: dbNameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"host": {
+			Description: hostDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"port": {
+			Description: portDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"instance": {
+			Description: instanceDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"encrypt": {
+			Description: encryptDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"trust_server_certificate": {
+			Description: trustCertDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"auth_method": {
+			Description: authMethodDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tenant_id": {
+			Description: tenantIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description: usernameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
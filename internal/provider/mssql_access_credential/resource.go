@@ -0,0 +1,231 @@
+package mssql_access_credential
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+func Resource() *schema.Resource {
+	return credutil.WithSecretDrift(&schema.Resource{
+		Description:   "Manage Microsoft SQL Server and Azure SQL dynamic access credentials in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: customdiff.All(validateAuthMethod, credutil.ForbidDeploymentIDsChange),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	})
+}
+
+// validateAuthMethod requires tenant_id for Entra ID authentication and
+// refuses it for SQL authentication, where it would be silently ignored.
+func validateAuthMethod(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("auth_method") || !d.NewValueKnown("tenant_id") {
+		return nil
+	}
+	hasTenantID := d.Get("tenant_id").(string) != ""
+	switch d.Get("auth_method").(string) {
+	case client.MSSQLAuthMethodEntraID:
+		if !hasTenantID {
+			return fmt.Errorf("tenant_id is required when auth_method is %q", client.MSSQLAuthMethodEntraID)
+		}
+	case client.MSSQLAuthMethodSQL:
+		if hasTenantID {
+			return fmt.Errorf("tenant_id is only used when auth_method is %q", client.MSSQLAuthMethodEntraID)
+		}
+	}
+	return nil
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	deploymentIDs := make([]string, 0)
+	if v, ok := d.GetOk("deployment_ids"); ok {
+		for _, item := range v.([]any) {
+			deploymentIDs = append(deploymentIDs, item.(string))
+		}
+	}
+
+	password := writeonly.GetString(d, "password", "password_wo")
+
+	input := &client.CreateMSSQLAccessCredentialInput{
+		Name:                   d.Get("name").(string),
+		Description:            d.Get("description").(string),
+		DeploymentIDs:          deploymentIDs,
+		SecretStoreID:          d.Get("secret_store_id").(string),
+		DBName:                 d.Get("db_name").(string),
+		Host:                   d.Get("host").(string),
+		Port:                   d.Get("port").(int),
+		Instance:               d.Get("instance").(string),
+		Encrypt:                d.Get("encrypt").(string),
+		TrustServerCertificate: d.Get("trust_server_certificate").(bool),
+		AuthMethod:             d.Get("auth_method").(string),
+		TenantID:               d.Get("tenant_id").(string),
+		Username:               d.Get("username").(string),
+		Password:               password,
+	}
+
+	credential, err := client.CreateMSSQLAccessCredential(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	credential, err := client.GetMSSQLAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	fields := map[string]any{
+		"name":                     credential.Name,
+		"description":              credential.Description,
+		"deployment_ids":           credential.DeploymentIDs,
+		"db_name":                  credential.DBName,
+		"host":                     credential.Host,
+		"port":                     credential.Port,
+		"instance":                 credential.Instance,
+		"encrypt":                  credential.Encrypt,
+		"trust_server_certificate": credential.TrustServerCertificate,
+		"auth_method":              credential.AuthMethod,
+		"tenant_id":                credential.TenantID,
+		"username":                 credential.Username,
+		"type":                     string(credential.Type),
+		"kind":                     credential.Kind,
+		"secret_store_id":          credential.SecretStoreID,
+		"secret_store_sync":        credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint":       credential.SecretFingerprint,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateMSSQLAccessCredentialInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("secret_store_id") {
+		v := d.Get("secret_store_id").(string)
+		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
+	}
+	if d.HasChange("db_name") {
+		v := d.Get("db_name").(string)
+		input.DBName = &v
+	}
+	if d.HasChange("host") {
+		v := d.Get("host").(string)
+		input.Host = &v
+	}
+	if d.HasChange("port") {
+		v := d.Get("port").(int)
+		input.Port = &v
+	}
+	if d.HasChange("instance") {
+		input.Instance = client.NewNullableString(d.Get("instance").(string))
+	}
+	if d.HasChange("encrypt") {
+		v := d.Get("encrypt").(string)
+		input.Encrypt = &v
+	}
+	if d.HasChange("trust_server_certificate") {
+		v := d.Get("trust_server_certificate").(bool)
+		input.TrustServerCertificate = &v
+	}
+	if d.HasChange("auth_method") {
+		v := d.Get("auth_method").(string)
+		input.AuthMethod = &v
+	}
+	if d.HasChange("tenant_id") {
+		input.TenantID = client.NewNullableString(d.Get("tenant_id").(string))
+	}
+	if d.HasChange("username") {
+		v := d.Get("username").(string)
+		input.Username = &v
+	}
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
+		password := writeonly.GetString(d, "password", "password_wo")
+		input.Password = &password
+	}
+
+	_, err := client.UpdateMSSQLAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
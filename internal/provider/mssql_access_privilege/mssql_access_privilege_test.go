@@ -0,0 +1,73 @@
+package mssql_access_privilege
+
+import (
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandGrants(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"privileges":    []any{"SELECT", "INSERT"},
+			"object_type":   "TABLE",
+			"schema_name":   "sales",
+			"object_names":  []any{"orders"},
+			"column_names":  []any{},
+			"all_in_schema": false,
+		},
+		map[string]any{
+			"privileges":    []any{"CONNECT"},
+			"object_type":   "DATABASE",
+			"schema_name":   "",
+			"object_names":  []any{},
+			"column_names":  []any{},
+			"all_in_schema": false,
+		},
+	}
+
+	result := expandGrants(input)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 grants, got %d", len(result))
+	}
+	if result[0].SchemaName != "sales" {
+		t.Errorf("expected schema_name 'sales', got '%s'", result[0].SchemaName)
+	}
+	if len(result[0].ObjectNames) != 1 || result[0].ObjectNames[0] != "orders" {
+		t.Errorf("unexpected object_names: %v", result[0].ObjectNames)
+	}
+	if result[0].ColumnNames != nil {
+		t.Errorf("expected no column_names, got %v", result[0].ColumnNames)
+	}
+	if result[1].ObjectType != "DATABASE" || result[1].SchemaName != "" {
+		t.Errorf("unexpected database grant: %+v", result[1])
+	}
+}
+
+func TestFlattenGrants(t *testing.T) {
+	input := []client.MSSQLGrant{
+		{
+			Privileges:  []string{"EXECUTE"},
+			ObjectType:  "PROCEDURE",
+			SchemaName:  "sales",
+			AllInSchema: true,
+		},
+	}
+
+	result := flattenGrants(input)
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 grant, got %d", len(result))
+	}
+	m := result[0].(map[string]any)
+	if m["schema_name"] != "sales" {
+		t.Errorf("expected schema_name 'sales', got '%v'", m["schema_name"])
+	}
+	if names := m["object_names"].([]string); len(names) != 0 {
+		t.Errorf("expected empty object_names, got %v", names)
+	}
+	if m["all_in_schema"] != true {
+		t.Errorf("expected all_in_schema true, got '%v'", m["all_in_schema"])
+	}
+}
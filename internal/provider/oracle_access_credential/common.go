@@ -0,0 +1,249 @@
+package oracle_access_credential
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+)
+
+const (
+	idDesc            = "The unique identifier of the Oracle Database access credential"
+	nameDesc          = "The name of the Oracle Database access credential"
+	descriptionDesc   = "The description of the Oracle Database access credential"
+	deploymentIDsDesc = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	hostDesc          = "The hostname or IP address of the Oracle Database listener"
+	portDesc          = "The port number of the Oracle Database listener (default: 1521, usually 2484 for TLS)"
+	serviceNameDesc   = "The service name of the database to connect to, for example a pluggable database's service. Exactly one of `service_name` and `sid` must be specified"
+	sidDesc           = "The system identifier (SID) of the database instance to connect to, for databases that are not reached through a service name. Exactly one of `service_name` and `sid` must be specified"
+	tlsDesc           = "Whether to connect over TLS (TCPS) (default: false)"
+	sslCADesc         = "The PEM CA certificate the listener's TLS certificate is verified against. Requires `tls`"
+	walletDesc        = "A base64-encoded Oracle wallet (a zip holding `cwallet.sso`, for example an Autonomous Database wallet, as produced by `filebase64()`), used for mutual TLS. Requires `tls`"
+	walletWODesc      = "A base64-encoded Oracle wallet used for mutual TLS (write-only). This is a write-only attribute that is more secure than `wallet` because Terraform will not store this value in the state file. Requires `tls`"
+	walletWOVerDesc   = "Used to trigger updates for `wallet_wo`. This value should be changed when the wallet changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	hasWalletDesc     = "Whether a wallet is configured. The API never returns the wallet itself"
+	usernameDesc      = "The admin user Hush creates the short-lived users with. It needs the CREATE USER, ALTER USER and DROP USER system privileges, and the privileges and roles it grants WITH ADMIN OPTION or WITH GRANT OPTION"
+	passwordDesc      = "The password of the admin user"
+	passwordWODesc    = "The password of the admin user (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Either `password` or `password_wo` must be specified."
+	passwordWOVerDesc = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	typeDesc          = "The type of access credential"
+	kindDesc          = "The kind of access credential"
+	secretStoreIDDesc = "The ID of the secret store where this credential is saved (optional)"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["deployment_ids"] = &schema.Schema{
+		Description: deploymentIDsDesc + ". Changing this after creation is not supported; the credential must be deleted and recreated.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^dep-`), "deployment_id must start with 'dep-'"),
+		},
+	}
+	s["secret_store_id"] = &schema.Schema{
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
+	}
+	s["host"] = &schema.Schema{
+		Description: hostDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s["port"] = &schema.Schema{
+		Description: portDesc,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     1521,
+	}
+	s["service_name"] = &schema.Schema{
+		Description:  serviceNameDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"service_name", "sid"},
+	}
+	s["sid"] = &schema.Schema{
+		Description:  sidDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"service_name", "sid"},
+	}
+	s["tls"] = &schema.Schema{
+		Description: tlsDesc,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["ssl_ca"] = &schema.Schema{
+		Description: sslCADesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["wallet"] = &schema.Schema{
+		Description:   walletDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"wallet_wo"},
+		ValidateFunc:  validation.StringIsBase64,
+	}
+	s["wallet_wo"] = &schema.Schema{
+		Description:   walletWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"wallet"},
+		RequiredWith:  []string{"wallet_wo_version"},
+		ValidateFunc:  validation.StringIsBase64,
+	}
+	s["wallet_wo_version"] = &schema.Schema{
+		Description:  walletWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"wallet_wo"},
+	}
+	s["username"] = &schema.Schema{
+		Description: usernameDesc,
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	s["password"] = &schema.Schema{
+		Description:   passwordDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"password_wo"},
+		ExactlyOneOf:  []string{"password", "password_wo"},
+	}
+	s["password_wo"] = &schema.Schema{
+		Description:   passwordWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"password"},
+		ExactlyOneOf:  []string{"password", "password_wo"},
+		RequiredWith:  []string{"password_wo_version"},
+	}
+	s["password_wo_version"] = &schema.Schema{
+		Description:  passwordWOVerDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"deployment_ids": {
+			Description: deploymentIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"host": {
+			Description: hostDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"port": {
+			Description: portDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"service_name": {
+			Description: serviceNameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"sid": {
+			Description: sidDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tls": {
+			Description: tlsDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"ssl_ca": {
+			Description: sslCADesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"has_wallet": {
+			Description: hasWalletDesc,
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"username": {
+			Description: usernameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"kind": {
+			Description: kindDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_id": {
+			Description: secretStoreIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
+	}
+}
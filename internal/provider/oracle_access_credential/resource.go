@@ -0,0 +1,217 @@
+package oracle_access_credential
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
+	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
+)
+
+func Resource() *schema.Resource {
+	return credutil.WithSecretDrift(&schema.Resource{
+		Description:   "Manage Oracle Database dynamic access credentials in the Hush Security platform.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		CustomizeDiff: customdiff.All(validateTLS, credutil.ForbidDeploymentIDsChange),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	})
+}
+
+// validateTLS refuses a CA certificate or wallet without tls, since the
+// listener would be reached over plain TCP and they would be ignored.
+func validateTLS(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("tls") || d.Get("tls").(bool) {
+		return nil
+	}
+	for _, attr := range []string{"ssl_ca", "wallet", "wallet_wo"} {
+		if writeonly.IsSet(d, attr) {
+			return fmt.Errorf("%s requires tls = true", attr)
+		}
+	}
+	return nil
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	deploymentIDs := make([]string, 0)
+	if v, ok := d.GetOk("deployment_ids"); ok {
+		for _, item := range v.([]any) {
+			deploymentIDs = append(deploymentIDs, item.(string))
+		}
+	}
+
+	password := writeonly.GetString(d, "password", "password_wo")
+
+	input := &client.CreateOracleAccessCredentialInput{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DeploymentIDs: deploymentIDs,
+		SecretStoreID: d.Get("secret_store_id").(string),
+		Host:          d.Get("host").(string),
+		Port:          d.Get("port").(int),
+		ServiceName:   d.Get("service_name").(string),
+		SID:           d.Get("sid").(string),
+		TLS:           d.Get("tls").(bool),
+		SSLCA:         d.Get("ssl_ca").(string),
+		Wallet:        writeonly.GetString(d, "wallet", "wallet_wo"),
+		Username:      d.Get("username").(string),
+		Password:      password,
+	}
+
+	credential, err := client.CreateOracleAccessCredential(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	id := d.Id()
+	if id == "" {
+		if v, ok := d.GetOk("id"); ok {
+			id = v.(string)
+		}
+	}
+
+	if id == "" {
+		return diag.Errorf("id is required")
+	}
+
+	credential, err := client.GetOracleAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.ID)
+
+	fields := map[string]any{
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"host":               credential.Host,
+		"port":               credential.Port,
+		"service_name":       credential.ServiceName,
+		"sid":                credential.SID,
+		"tls":                credential.TLS,
+		"ssl_ca":             credential.SSLCA,
+		"has_wallet":         credential.HasWallet,
+		"username":           credential.Username,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	input := &client.UpdateOracleAccessCredentialInput{}
+
+	if d.HasChange("name") {
+		v := d.Get("name").(string)
+		input.Name = &v
+	}
+	if d.HasChange("description") {
+		v := d.Get("description").(string)
+		input.Description = &v
+	}
+	if d.HasChange("secret_store_id") {
+		v := d.Get("secret_store_id").(string)
+		input.SecretStoreID = client.NewSecretStoreIDUpdate(v)
+	}
+	if d.HasChange("host") {
+		v := d.Get("host").(string)
+		input.Host = &v
+	}
+	if d.HasChange("port") {
+		v := d.Get("port").(int)
+		input.Port = &v
+	}
+	if d.HasChange("service_name") {
+		input.ServiceName = client.NewNullableString(d.Get("service_name").(string))
+	}
+	if d.HasChange("sid") {
+		input.SID = client.NewNullableString(d.Get("sid").(string))
+	}
+	if d.HasChange("tls") {
+		v := d.Get("tls").(bool)
+		input.TLS = &v
+	}
+	if d.HasChange("ssl_ca") {
+		input.SSLCA = client.NewNullableString(d.Get("ssl_ca").(string))
+	}
+	if d.HasChange("wallet") || d.HasChange("wallet_wo_version") || credutil.ResendSecret(d, "wallet", "wallet_wo") {
+		input.Wallet = client.NewNullableString(writeonly.GetString(d, "wallet", "wallet_wo"))
+	}
+	if d.HasChange("username") {
+		v := d.Get("username").(string)
+		input.Username = &v
+	}
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
+		password := writeonly.GetString(d, "password", "password_wo")
+		input.Password = &password
+	}
+
+	_, err := client.UpdateOracleAccessCredential(ctx, c, id, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRead(ctx, d, meta)
+}
+
+func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := meta.(*client.Client)
+	id := d.Id()
+
+	err := client.DeleteAccessCredential(ctx, c, id)
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package oracle_access_privilege
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+const (
+	idDesc               = "The unique identifier of the Oracle Database access privilege"
+	nameDesc             = "The name of the Oracle Database access privilege"
+	descriptionDesc      = "The description of the Oracle Database access privilege"
+	systemPrivilegesDesc = "The system privileges granted to the short-lived users (e.g., CREATE SESSION, SELECT ANY DICTIONARY)"
+	objectGrantsDesc     = "The privileges granted on objects in a schema"
+	rolesDesc            = "The roles granted to the short-lived users (e.g., CONNECT, or an application role)"
+	typeDesc             = "The type of access privilege"
+)
+
+// grantsAtLeastOneOf requires a privilege to grant something.
+var grantsAtLeastOneOf = []string{"system_privileges", "object_grants", "roles"}
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := DataSourceSchema()
+
+	s["id"] = &schema.Schema{
+		Description: idDesc,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["name"] = &schema.Schema{
+		Description:  nameDesc,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 255),
+	}
+	s["description"] = &schema.Schema{
+		Description:  descriptionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 1000),
+	}
+	s["system_privileges"] = &schema.Schema{
+		Description:  systemPrivilegesDesc,
+		Type:         schema.TypeList,
+		Optional:     true,
+		AtLeastOneOf: grantsAtLeastOneOf,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+	s["object_grants"] = &schema.Schema{
+		Description:  objectGrantsDesc,
+		Type:         schema.TypeList,
+		Optional:     true,
+		AtLeastOneOf: grantsAtLeastOneOf,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privileges": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The list of object privileges (e.g., SELECT, INSERT, UPDATE, DELETE, EXECUTE)",
+				},
+				"object_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"TABLE", "VIEW", "SEQUENCE", "PROCEDURE", "FUNCTION", "PACKAGE", "TYPE"}, false),
+					Description:  "The type of schema object (TABLE, VIEW, SEQUENCE, PROCEDURE, FUNCTION, PACKAGE, TYPE)",
+				},
+				"schema_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The schema (owner) of the objects",
+				},
+				"object_names": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The names of the objects",
+				},
+				"all_in_schema": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Grant on all objects of the given type in the schema",
+				},
+			},
+		},
+	}
+	s["roles"] = &schema.Schema{
+		Description:  rolesDesc,
+		Type:         schema.TypeList,
+		Optional:     true,
+		AtLeastOneOf: grantsAtLeastOneOf,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	return s
+}
+
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: idDesc,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: nameDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: descriptionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"system_privileges": {
+			Description: systemPrivilegesDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"object_grants": {
+			Description: objectGrantsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"privileges": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The list of object privileges",
+					},
+					"object_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type of schema object",
+					},
+					"schema_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The schema (owner) of the objects",
+					},
+					"object_names": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The names of the objects",
+					},
+					"all_in_schema": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Grant on all objects of the given type in the schema",
+					},
+				},
+			},
+		},
+		"roles": {
+			Description: rolesDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"type": {
+			Description: typeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func expandStrings(list []any) []string {
+	result := make([]string, len(list))
+	for i, v := range list {
+		result[i] = v.(string)
+	}
+	return result
+}
+
+func expandObjectGrants(list []any) []client.OracleObjectGrant {
+	grants := make([]client.OracleObjectGrant, len(list))
+	for i, v := range list {
+		m := v.(map[string]any)
+		grant := client.OracleObjectGrant{
+			ObjectType:  m["object_type"].(string),
+			SchemaName:  m["schema_name"].(string),
+			Privileges:  expandStrings(m["privileges"].([]any)),
+			AllInSchema: m["all_in_schema"].(bool),
+		}
+		if names, ok := m["object_names"].([]any); ok && len(names) > 0 {
+			grant.ObjectNames = expandStrings(names)
+		}
+		grants[i] = grant
+	}
+	return grants
+}
+
+func flattenObjectGrants(grants []client.OracleObjectGrant) []any {
+	result := make([]any, len(grants))
+	for i, g := range grants {
+		privileges := g.Privileges
+		if privileges == nil {
+			privileges = []string{}
+		}
+		objectNames := g.ObjectNames
+		if objectNames == nil {
+			objectNames = []string{}
+		}
+		result[i] = map[string]any{
+			"privileges":    privileges,
+			"object_type":   g.ObjectType,
+			"schema_name":   g.SchemaName,
+			"object_names":  objectNames,
+			"all_in_schema": g.AllInSchema,
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package oracle_access_privilege
+
+import (
+	"testing"
+
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func TestExpandObjectGrants(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"privileges":    []any{"SELECT", "INSERT"},
+			"object_type":   "TABLE",
+			"schema_name":   "FINANCE",
+			"object_names":  []any{"LEDGER", "INVOICES"},
+			"all_in_schema": false,
+		},
+		map[string]any{
+			"privileges":    []any{"EXECUTE"},
+			"object_type":   "PACKAGE",
+			"schema_name":   "FINANCE",
+			"object_names":  []any{},
+			"all_in_schema": true,
+		},
+	}
+
+	result := expandObjectGrants(input)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 grants, got %d", len(result))
+	}
+	if result[0].SchemaName != "FINANCE" || result[0].ObjectType != "TABLE" {
+		t.Errorf("unexpected first grant: %+v", result[0])
+	}
+	if len(result[0].ObjectNames) != 2 || result[0].ObjectNames[1] != "INVOICES" {
+		t.Errorf("unexpected object_names: %v", result[0].ObjectNames)
+	}
+	if result[1].ObjectNames != nil {
+		t.Errorf("expected no object_names, got %v", result[1].ObjectNames)
+	}
+	if !result[1].AllInSchema {
+		t.Errorf("expected all_in_schema true, got %v", result[1].AllInSchema)
+	}
+}
+
+func TestFlattenObjectGrants(t *testing.T) {
+	input := []client.OracleObjectGrant{
+		{
+			Privileges:  []string{"SELECT"},
+			ObjectType:  "VIEW",
+			SchemaName:  "FINANCE",
+			AllInSchema: true,
+		},
+	}
+
+	result := flattenObjectGrants(input)
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 grant, got %d", len(result))
+	}
+	m := result[0].(map[string]any)
+	if m["schema_name"] != "FINANCE" {
+		t.Errorf("expected schema_name 'FINANCE', got '%v'", m["schema_name"])
+	}
+	if names := m["object_names"].([]string); len(names) != 0 {
+		t.Errorf("expected empty object_names, got %v", names)
+	}
+}
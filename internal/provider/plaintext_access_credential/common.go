@@ -5,20 +5,33 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
-	idDesc              = "The unique identifier of the plaintext access credential"
-	nameDesc            = "The name of the plaintext access credential"
-	descriptionDesc     = "The description of the plaintext access credential"
-	deploymentIDsDesc   = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
-	secretDesc          = "The secret value for the plaintext credential"
-	secretWODesc        = "The secret value for the plaintext credential (write-only). This is a write-only attribute that is more secure than `secret` because Terraform will not store this value in the state file. Either `secret` or `secret_wo` must be specified."
-	secretWOVersionDesc = "Used to trigger updates for `secret_wo`. This value should be changed when the secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
-	typeDesc            = "The type of access credential (always PLAINTEXT for this resource)"
-	secretStoreIDDesc   = "The ID of the secret store where this credential is saved (optional)"
+	idDesc                    = "The unique identifier of the plaintext access credential"
+	nameDesc                  = "The name of the plaintext access credential"
+	descriptionDesc           = "The description of the plaintext access credential"
+	deploymentIDsDesc         = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	secretDesc                = "The secret value for the plaintext credential"
+	secretWODesc              = "The secret value for the plaintext credential (write-only). This is a write-only attribute that is more secure than `secret` because Terraform will not store this value in the state file. Exactly one of `secret`, `secret_wo`, `secret_base64` and `secret_base64_wo` must be specified."
+	secretWOVersionDesc       = "Used to trigger updates for `secret_wo`. This value should be changed when the secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	secretBase64Desc          = "The secret value for the plaintext credential as base64, for binary secrets such as keystores, PKCS#12 bundles and GPG keys, e.g. `filebase64(\"keystore.p12\")`. Volume delivery writes the decoded bytes; environment variable delivery delivers the base64 text"
+	secretBase64WODesc        = "The base64 secret value for the plaintext credential (write-only). Terraform will not store this value in the state file. Exactly one of `secret`, `secret_wo`, `secret_base64` and `secret_base64_wo` must be specified."
+	secretBase64WOVersionDesc = "Used to trigger updates for `secret_base64_wo`. This value should be changed when the secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	secretEncodingDesc        = "How the secret is stored: `text`, or `base64` when set through `secret_base64` or `secret_base64_wo`"
+	contentTypeDesc           = "A MIME type describing the secret, e.g. application/x-pkcs12. A hint for consumers of the secret; Hush does not interpret it"
+	typeDesc                  = "The type of access credential (always PLAINTEXT for this resource)"
+	secretStoreIDDesc         = "The ID of the secret store where this credential is saved (optional)"
 )
 
+// secretExactlyOneOf names every way of setting the secret, of which exactly
+// one must be used.
+var secretExactlyOneOf = []string{"secret", "secret_wo", "secret_base64", "secret_base64_wo"}
+
+var contentTypeRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*$`)
+
 func PlaintextAccessCredentialResourceSchema() map[string]*schema.Schema {
 	s := PlaintextAccessCredentialDataSourceSchema()
 
@@ -51,7 +64,7 @@ func PlaintextAccessCredentialResourceSchema() map[string]*schema.Schema {
 		},
 	}
 	s["secret_store_id"] = &schema.Schema{
-		Description:  secretStoreIDDesc,
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
@@ -62,7 +75,7 @@ func PlaintextAccessCredentialResourceSchema() map[string]*schema.Schema {
 		Optional:      true,
 		Sensitive:     true,
 		ConflictsWith: []string{"secret_wo"},
-		ExactlyOneOf:  []string{"secret", "secret_wo"},
+		ExactlyOneOf:  secretExactlyOneOf,
 	}
 	s["secret_wo"] = &schema.Schema{
 		Description:   secretWODesc,
@@ -71,7 +84,7 @@ func PlaintextAccessCredentialResourceSchema() map[string]*schema.Schema {
 		Sensitive:     true,
 		WriteOnly:     true,
 		ConflictsWith: []string{"secret"},
-		ExactlyOneOf:  []string{"secret", "secret_wo"},
+		ExactlyOneOf:  secretExactlyOneOf,
 		RequiredWith:  []string{"secret_wo_version"},
 	}
 	s["secret_wo_version"] = &schema.Schema{
@@ -80,6 +93,42 @@ func PlaintextAccessCredentialResourceSchema() map[string]*schema.Schema {
 		Optional:     true,
 		RequiredWith: []string{"secret_wo"},
 	}
+	s["secret_base64"] = &schema.Schema{
+		Description:   secretBase64Desc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"secret_base64_wo"},
+		ExactlyOneOf:  secretExactlyOneOf,
+		ValidateFunc:  validation.StringIsBase64,
+	}
+	s["secret_base64_wo"] = &schema.Schema{
+		Description:   secretBase64WODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"secret_base64"},
+		ExactlyOneOf:  secretExactlyOneOf,
+		RequiredWith:  []string{"secret_base64_wo_version"},
+		ValidateFunc:  validation.StringIsBase64,
+	}
+	s["secret_base64_wo_version"] = &schema.Schema{
+		Description:  secretBase64WOVersionDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"secret_base64_wo"},
+	}
+	s["content_type"] = &schema.Schema{
+		Description:  contentTypeDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(contentTypeRegex, "content_type must be a MIME type such as application/x-pkcs12"),
+	}
+
+	s["rotation"] = credutil.RotationResourceSchema()
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
 
 	return s
 }
@@ -119,5 +168,18 @@ func PlaintextAccessCredentialDataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"secret_store_sync": credutil.SecretStoreSyncSchema(),
+		"secret_encoding": {
+			Description: secretEncodingDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"content_type": {
+			Description: contentTypeDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rotation":           credutil.RotationDataSourceSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
 	}
 }
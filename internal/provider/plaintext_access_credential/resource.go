@@ -8,11 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage plaintext access credentials in the Hush Security platform. Plaintext credentials store a single secret value that can be delivered as an environment variable to specified deployments.",
 		CreateContext: plaintextAccessCredentialCreate,
 		ReadContext:   plaintextAccessCredentialRead,
@@ -23,7 +24,15 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: PlaintextAccessCredentialResourceSchema(),
+	})
+}
+
+// getSecret returns the configured secret and how it is encoded.
+func getSecret(d *schema.ResourceData) (string, client.SecretEncoding) {
+	if v := writeonly.GetString(d, "secret_base64", "secret_base64_wo"); v != "" {
+		return v, client.SecretEncodingBase64
 	}
+	return writeonly.GetString(d, "secret", "secret_wo"), client.SecretEncodingText
 }
 
 func plaintextAccessCredentialCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -37,14 +46,17 @@ func plaintextAccessCredentialCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	secret := writeonly.GetString(d, "secret", "secret_wo")
+	secret, encoding := getSecret(d)
 
 	input := &client.CreatePlaintextAccessCredentialInput{
-		Name:          d.Get("name").(string),
-		Description:   d.Get("description").(string),
-		DeploymentIDs: deploymentIDs,
-		SecretStoreID: d.Get("secret_store_id").(string),
-		Secret:        secret,
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		DeploymentIDs:  deploymentIDs,
+		SecretStoreID:  d.Get("secret_store_id").(string),
+		Secret:         secret,
+		SecretEncoding: encoding,
+		ContentType:    d.Get("content_type").(string),
+		Rotation:       credutil.ExpandRotation(d.Get("rotation").([]any)),
 	}
 
 	credential, err := client.CreatePlaintextAccessCredential(ctx, c, input)
@@ -97,6 +109,25 @@ func plaintextAccessCredentialRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("secret_store_id", credential.SecretStoreID); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("secret_store_sync", credutil.FlattenSecretStoreSync(credential.SecretStoreSync)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret_fingerprint", credential.SecretFingerprint); err != nil {
+		return diag.FromErr(err)
+	}
+	encoding := credential.SecretEncoding
+	if encoding == "" {
+		encoding = client.SecretEncodingText
+	}
+	if err := d.Set("secret_encoding", string(encoding)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content_type", credential.ContentType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rotation", credutil.FlattenRotation(credential.Rotation, d)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -122,9 +153,18 @@ func plaintextAccessCredentialUpdate(ctx context.Context, d *schema.ResourceData
 		input.SecretStoreID = client.NewSecretStoreIDUpdate(secretStoreID)
 	}
 
-	if d.HasChange("secret") || d.HasChange("secret_wo") || d.HasChange("secret_wo_version") {
-		secret := writeonly.GetString(d, "secret", "secret_wo")
+	if d.HasChanges("secret", "secret_wo", "secret_wo_version", "secret_base64", "secret_base64_wo", "secret_base64_wo_version") || credutil.SecretDrifted(d) {
+		secret, encoding := getSecret(d)
 		input.Secret = &secret
+		input.SecretEncoding = encoding
+	}
+
+	if d.HasChange("content_type") {
+		input.ContentType = client.NewNullableString(d.Get("content_type").(string))
+	}
+
+	if d.HasChange("rotation") {
+		input.Rotation = client.NewRotationUpdate(credutil.ExpandRotation(d.Get("rotation").([]any)))
 	}
 
 	_, err := client.UpdatePlaintextAccessCredential(ctx, c, id, input)
@@ -132,10 +172,22 @@ func plaintextAccessCredentialUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.RotateIfRequested(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return plaintextAccessCredentialRead(ctx, d, meta)
 }
 
 func plaintextAccessCredentialDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
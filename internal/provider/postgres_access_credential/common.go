@@ -5,25 +5,55 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 )
 
 const (
-	idDesc            = "The unique identifier of the PostgreSQL access credential"
-	nameDesc          = "The name of the PostgreSQL access credential"
-	descriptionDesc   = "The description of the PostgreSQL access credential"
-	deploymentIDsDesc = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
-	dbNameDesc        = "The name of the PostgreSQL database"
-	hostDesc          = "The hostname or IP address of the PostgreSQL server"
-	portDesc          = "The port number of the PostgreSQL server (default: 5432)"
-	sslModeDesc       = "The SSL mode for the PostgreSQL connection (default: prefer)"
-	sslCADesc         = "The SSL CA certificate for the PostgreSQL connection"
-	usernameDesc      = "The username for the PostgreSQL connection"
-	passwordDesc      = "The password for the PostgreSQL connection"
-	passwordWODesc    = "The password for the PostgreSQL connection (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Either `password` or `password_wo` must be specified."
-	passwordWOVerDesc = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
-	typeDesc          = "The type of access credential"
-	kindDesc          = "The kind of access credential"
-	secretStoreIDDesc = "The ID of the secret store where this credential is saved (optional)"
+	engineNative      = "native"
+	engineRDSIAM      = "rds_iam"
+	engineCloudSQLIAM = "cloudsql_iam"
+	engineAzureAD     = "azure_ad"
+)
+
+var validEngines = []string{engineNative, engineRDSIAM, engineCloudSQLIAM, engineAzureAD}
+
+// instanceConnectionNameRegex matches a Cloud SQL instance connection name,
+// project:region:instance. Domain-scoped projects carry their own colon.
+var instanceConnectionNameRegex = regexp.MustCompile(`^([a-z0-9.-]+:)?[a-z][a-z0-9-]*:[a-z0-9-]+:[a-z][a-z0-9-]*$`)
+
+const (
+	idDesc              = "The unique identifier of the PostgreSQL access credential"
+	nameDesc            = "The name of the PostgreSQL access credential"
+	descriptionDesc     = "The description of the PostgreSQL access credential"
+	deploymentIDsDesc   = "List of deployment IDs that can access this credential. Currently limited to a single deployment"
+	dbNameDesc          = "The name of the PostgreSQL database"
+	hostDesc            = "The hostname or IP address of the PostgreSQL server"
+	portDesc            = "The port number of the PostgreSQL server (default: 5432)"
+	sslModeDesc         = "The SSL mode for the PostgreSQL connection (default: prefer)"
+	sslCADesc           = "The SSL CA certificate for the PostgreSQL connection"
+	usernameDesc        = "The username for the PostgreSQL connection. With an IAM engine, this is the database user mapped to the cloud identity Hush authenticates as; it needs CREATEROLE"
+	passwordDesc        = "The password for the PostgreSQL connection. Required when `engine` is `native`; must not be set for the IAM engines."
+	passwordWODesc      = "The password for the PostgreSQL connection (write-only). This is a write-only attribute that is more secure than `password` because Terraform will not store this value in the state file. Required when `engine` is `native`; must not be set for the IAM engines."
+	passwordWOVerDesc   = "Used to trigger updates for `password_wo`. This value should be changed when the password content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	engineDesc          = "How Hush authenticates to the server. `native` (the default) logs in with `username` and `password` and creates password users. `rds_iam` logs in with an Amazon RDS IAM authentication token and creates users granted `rds_iam`. `cloudsql_iam` logs in as a Cloud SQL IAM service account user and creates IAM database users through the Cloud SQL Admin API. `azure_ad` logs in with a Microsoft Entra ID token and creates users mapped to Entra ID principals. The IAM engines need no static admin password. Immutable; changing it forces replacement."
+	regionDesc          = "The AWS region of the RDS instance or Aurora cluster. Required and only valid when `engine` is `rds_iam`."
+	accessKeyIDDesc     = "The AWS access key ID used to sign RDS authentication tokens. Only valid when `engine` is `rds_iam`. Must be set together with `secret_access_key` (or `secret_access_key_wo`); omit both to use AWS workload identity federation (IRSA / instance profile / WIF)."
+	secretKeyDesc       = "The AWS secret access key used to sign RDS authentication tokens. Only valid when `engine` is `rds_iam`. Must be set together with `access_key_id`. Omit both to use AWS workload identity federation."
+	secretKeyWODesc     = "The AWS secret access key (write-only). This is a write-only attribute that is more secure than `secret_access_key` because Terraform will not store this value in the state file. Only valid when `engine` is `rds_iam`. Must be set together with `access_key_id`; omit both to use AWS workload identity federation."
+	secretKeyWOVDesc    = "Used to trigger updates for `secret_access_key_wo`. This value should be changed when the secret content changes."
+	instanceConnDesc    = "The Cloud SQL instance connection name, `project:region:instance`. Required and only valid when `engine` is `cloudsql_iam`."
+	saKeyDesc           = "The GCP service account key JSON Hush authenticates with. Only valid when `engine` is `cloudsql_iam`. Omit it to use GCP workload identity federation."
+	saKeyWODesc         = "The GCP service account key JSON (write-only). This is a write-only attribute that is more secure than `service_account_key` because Terraform will not store this value in the state file. Only valid when `engine` is `cloudsql_iam`."
+	saKeyWOVDesc        = "Used to trigger updates for `service_account_key_wo`. This value should be changed when the service account key content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	tenantIDDesc        = "The Microsoft Entra ID tenant of the Azure Database for PostgreSQL server. Required and only valid when `engine` is `azure_ad`."
+	clientIDDesc        = "The client ID of the Azure application Hush authenticates as. Only valid when `engine` is `azure_ad`. Must be set together with `client_secret` (or `client_secret_wo`); omit both to use the access-manager's default Azure credentials (managed identity / workload identity)."
+	clientSecretDesc    = "The client secret of the Azure application identified by `client_id`. Only valid when `engine` is `azure_ad`. Must be set together with `client_id`."
+	clientSecretWODesc  = "The client secret of the Azure application identified by `client_id` (write-only). This is a write-only attribute that is more secure than `client_secret` because Terraform will not store this value in the state file. Only valid when `engine` is `azure_ad`. Must be set together with `client_id`."
+	clientSecretWOVDesc = "Used to trigger updates for `client_secret_wo`. This value should be changed when the client secret content changes. Can be any value (e.g., a timestamp, version number, or hash)."
+	typeDesc            = "The type of access credential"
+	kindDesc            = "The kind of access credential"
+	secretStoreIDDesc   = "The ID of the secret store where this credential is saved (optional)"
 )
 
 func ResourceSchema() map[string]*schema.Schema {
@@ -58,7 +88,7 @@ func ResourceSchema() map[string]*schema.Schema {
 		},
 	}
 	s["secret_store_id"] = &schema.Schema{
-		Description:  secretStoreIDDesc,
+		Description:  secretStoreIDDesc + ". Changing it moves the credential's values to the new secret store; the apply waits until the move completes and fails if any store reports an error.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sst-`), "secret_store_id must start with 'sst-'"),
@@ -102,7 +132,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		Optional:      true,
 		Sensitive:     true,
 		ConflictsWith: []string{"password_wo"},
-		ExactlyOneOf:  []string{"password", "password_wo"},
 	}
 	s["password_wo"] = &schema.Schema{
 		Description:   passwordWODesc,
@@ -111,7 +140,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		Sensitive:     true,
 		WriteOnly:     true,
 		ConflictsWith: []string{"password"},
-		ExactlyOneOf:  []string{"password", "password_wo"},
 		RequiredWith:  []string{"password_wo_version"},
 	}
 	s["password_wo_version"] = &schema.Schema{
@@ -120,6 +148,119 @@ func ResourceSchema() map[string]*schema.Schema {
 		Optional:     true,
 		RequiredWith: []string{"password_wo"},
 	}
+	// engine defaults to native so that configurations written before the IAM
+	// engines existed keep planning cleanly. Per-engine requiredness, including
+	// the password's, is enforced in CustomizeDiff (validateEngineFields).
+	s["engine"] = &schema.Schema{
+		Description:      engineDesc,
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          engineNative,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(validEngines, false),
+		DiffSuppressFunc: suppressLegacyNativeEngine,
+	}
+	// RDS IAM engine fields.
+	s["region"] = &schema.Schema{
+		Description: regionDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["access_key_id"] = &schema.Schema{
+		Description: accessKeyIDDesc,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	s["secret_access_key"] = &schema.Schema{
+		Description:   secretKeyDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"secret_access_key_wo"},
+	}
+	s["secret_access_key_wo"] = &schema.Schema{
+		Description:   secretKeyWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"secret_access_key"},
+		RequiredWith:  []string{"secret_access_key_wo_version"},
+	}
+	s["secret_access_key_wo_version"] = &schema.Schema{
+		Description:  secretKeyWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"secret_access_key_wo"},
+	}
+	// Cloud SQL IAM engine fields.
+	s["instance_connection_name"] = &schema.Schema{
+		Description:  instanceConnDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(instanceConnectionNameRegex, "instance_connection_name must be project:region:instance"),
+	}
+	s["service_account_key"] = &schema.Schema{
+		Description:   saKeyDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"service_account_key_wo"},
+		ValidateFunc:  validation.StringIsJSON,
+	}
+	s["service_account_key_wo"] = &schema.Schema{
+		Description:   saKeyWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"service_account_key"},
+		RequiredWith:  []string{"service_account_key_wo_version"},
+		ValidateFunc:  validation.StringIsJSON,
+	}
+	s["service_account_key_wo_version"] = &schema.Schema{
+		Description:  saKeyWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"service_account_key_wo"},
+	}
+	// Azure AD engine fields.
+	s["tenant_id"] = &schema.Schema{
+		Description:  tenantIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	s["client_id"] = &schema.Schema{
+		Description:  clientIDDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 256),
+	}
+	s["client_secret"] = &schema.Schema{
+		Description:   clientSecretDesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"client_secret_wo"},
+	}
+	s["client_secret_wo"] = &schema.Schema{
+		Description:   clientSecretWODesc,
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"client_secret"},
+		RequiredWith:  []string{"client_secret_wo_version"},
+	}
+	s["client_secret_wo_version"] = &schema.Schema{
+		Description:  clientSecretWOVDesc,
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"client_secret_wo"},
+	}
+
+	s["deletion_protection"] = deletionprotection.Schema("access credential")
 
 	return s
 }
@@ -179,6 +320,36 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"engine": {
+			Description: engineDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"region": {
+			Description: regionDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"access_key_id": {
+			Description: accessKeyIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"instance_connection_name": {
+			Description: instanceConnDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tenant_id": {
+			Description: tenantIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"client_id": {
+			Description: clientIDDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"type": {
 			Description: typeDesc,
 			Type:        schema.TypeString,
@@ -194,5 +365,14 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"secret_store_sync":  credutil.SecretStoreSyncSchema(),
+		"secret_fingerprint": credutil.SecretFingerprintSchema(),
 	}
 }
+
+// suppressLegacyNativeEngine hides the engine diff on credentials created
+// before the attribute existed, whose state holds no engine until the next
+// refresh. Without it, the default would plan a replacement.
+func suppressLegacyNativeEngine(_, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == "" && new == engineNative
+}
@@ -2,30 +2,143 @@ package postgres_access_credential
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage PostgreSQL dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
-		CustomizeDiff: credutil.ForbidDeploymentIDsChange,
+		CustomizeDiff: customizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
+	})
+}
+
+// customizeDiff rejects deployment_ids changes after creation and enforces the
+// per-engine field rules: native requires a password, and each IAM engine
+// requires its cloud locator and forbids the password and the other engines'
+// fields.
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if err := credutil.ForbidDeploymentIDsChange(ctx, d, meta); err != nil {
+		return err
+	}
+	return validateEngineFields(d)
+}
+
+// Field groups owned by a single engine, used to build the per-engine forbidden
+// lists below.
+var (
+	rdsIAMFields      = []string{"region", "access_key_id", "secret_access_key"}
+	cloudSQLIAMFields = []string{"instance_connection_name", "service_account_key"}
+	azureADFields     = []string{"tenant_id", "client_id", "client_secret"}
+)
+
+func validateEngineFields(d *schema.ResourceDiff) error {
+	engine := d.Get("engine").(string)
+
+	var required, forbidden []string
+	switch engine {
+	case engineNative:
+		required = []string{"password"}
+		forbidden = slices.Concat(rdsIAMFields, cloudSQLIAMFields, azureADFields)
+	case engineRDSIAM:
+		required = []string{"region"}
+		forbidden = slices.Concat([]string{"password"}, cloudSQLIAMFields, azureADFields)
+	case engineCloudSQLIAM:
+		required = []string{"instance_connection_name"}
+		forbidden = slices.Concat([]string{"password"}, rdsIAMFields, azureADFields)
+	case engineAzureAD:
+		required = []string{"tenant_id"}
+		forbidden = slices.Concat([]string{"password"}, rdsIAMFields, cloudSQLIAMFields)
+	default:
+		return nil
+	}
+
+	var missing []string
+	for _, f := range required {
+		if !attrSet(d, f) {
+			missing = append(missing, f)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("engine %q requires: %s", engine, strings.Join(missing, ", "))
+	}
+
+	var present []string
+	for _, f := range forbidden {
+		if attrSet(d, f) {
+			present = append(present, f)
+		}
+	}
+	if len(present) > 0 {
+		return fmt.Errorf("engine %q does not allow: %s", engine, strings.Join(present, ", "))
+	}
+
+	switch engine {
+	case engineRDSIAM:
+		if attrSet(d, "access_key_id") != attrSet(d, "secret_access_key") {
+			return fmt.Errorf("engine %q requires access_key_id and secret_access_key to both be set or both be omitted "+
+				"(omit both to use AWS workload identity federation)", engine)
+		}
+	case engineAzureAD:
+		if attrSet(d, "client_id") != attrSet(d, "client_secret") {
+			return fmt.Errorf("engine %q requires client_id and client_secret to both be set or both be omitted "+
+				"(omit both to use the access-manager's default Azure credentials)", engine)
+		}
+	}
+
+	return nil
+}
+
+// attrSet reports whether attr is configured. Each secret may be supplied via
+// its plain attribute or its write-only counterpart, so either counts as set.
+func attrSet(d *schema.ResourceDiff, attr string) bool {
+	switch attr {
+	case "password", "secret_access_key", "service_account_key", "client_secret":
+		return rawSet(d, attr) || rawSet(d, attr+"_wo")
+	default:
+		return rawSet(d, attr)
 	}
 }
 
+// rawSet reports whether attr is configured in raw config. An unknown value (a
+// reference resolved at apply, e.g. random_password.x.result) counts as set and
+// is validated by the backend; null does not, so schema defaults stay unset.
+func rawSet(d *schema.ResourceDiff, attr string) bool {
+	rc := d.GetRawConfig()
+	if rc.IsNull() {
+		return false
+	}
+	v := rc.GetAttr(attr)
+	if v.IsNull() {
+		return false
+	}
+	if !v.IsKnown() {
+		return true
+	}
+	if v.Type() == cty.String {
+		return v.AsString() != ""
+	}
+	return true
+}
+
 func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*client.Client)
 
@@ -36,8 +149,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
-	password := writeonly.GetString(d, "password", "password_wo")
-
+	engine := d.Get("engine").(string)
 	input := &client.CreatePostgresAccessCredentialInput{
 		Name:          d.Get("name").(string),
 		Description:   d.Get("description").(string),
@@ -48,13 +160,29 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		Port:          d.Get("port").(int),
 		SSLMode:       d.Get("ssl_mode").(string),
 		Username:      d.Get("username").(string),
-		Password:      password,
+		Engine:        engine,
 	}
 
 	if v, ok := d.GetOk("ssl_ca"); ok {
 		input.SSLCA = v.(string)
 	}
 
+	switch engine {
+	case engineNative:
+		input.Password = writeonly.GetString(d, "password", "password_wo")
+	case engineRDSIAM:
+		input.Region = d.Get("region").(string)
+		input.AccessKeyID = d.Get("access_key_id").(string)
+		input.SecretAccessKey = writeonly.GetString(d, "secret_access_key", "secret_access_key_wo")
+	case engineCloudSQLIAM:
+		input.InstanceConnectionName = d.Get("instance_connection_name").(string)
+		input.ServiceAccountKey = writeonly.GetString(d, "service_account_key", "service_account_key_wo")
+	case engineAzureAD:
+		input.TenantID = d.Get("tenant_id").(string)
+		input.ClientID = d.Get("client_id").(string)
+		input.ClientSecret = writeonly.GetString(d, "client_secret", "client_secret_wo")
+	}
+
 	credential, err := client.CreatePostgresAccessCredential(ctx, c, input)
 	if err != nil {
 		return diag.FromErr(err)
@@ -90,19 +218,33 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 
 	d.SetId(credential.ID)
 
+	// Credentials created before the IAM engines existed report no engine.
+	engine := credential.Engine
+	if engine == "" {
+		engine = engineNative
+	}
+
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"db_name":         credential.DBName,
-		"host":            credential.Host,
-		"port":            credential.Port,
-		"ssl_mode":        credential.SSLMode,
-		"ssl_ca":          credential.SSLCA,
-		"username":        credential.Username,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":                     credential.Name,
+		"description":              credential.Description,
+		"deployment_ids":           credential.DeploymentIDs,
+		"db_name":                  credential.DBName,
+		"host":                     credential.Host,
+		"port":                     credential.Port,
+		"ssl_mode":                 credential.SSLMode,
+		"ssl_ca":                   credential.SSLCA,
+		"username":                 credential.Username,
+		"engine":                   engine,
+		"region":                   credential.Region,
+		"access_key_id":            credential.AccessKeyID,
+		"instance_connection_name": credential.InstanceConnectionName,
+		"tenant_id":                credential.TenantID,
+		"client_id":                credential.ClientID,
+		"type":                     string(credential.Type),
+		"kind":                     credential.Kind,
+		"secret_store_id":          credential.SecretStoreID,
+		"secret_store_sync":        credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint":       credential.SecretFingerprint,
 	}
 
 	for field, value := range fields {
@@ -156,20 +298,55 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		v := d.Get("username").(string)
 		input.Username = &v
 	}
-	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") {
+	if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
 		password := writeonly.GetString(d, "password", "password_wo")
 		input.Password = &password
 	}
+	if d.HasChange("region") {
+		v := d.Get("region").(string)
+		input.Region = &v
+	}
+	if d.HasChange("access_key_id") {
+		input.AccessKeyID = client.NewNullableString(d.Get("access_key_id").(string))
+	}
+	if d.HasChange("secret_access_key") || d.HasChange("secret_access_key_wo") || d.HasChange("secret_access_key_wo_version") || credutil.ResendSecret(d, "secret_access_key", "secret_access_key_wo") {
+		input.SecretAccessKey = client.NewNullableString(writeonly.GetString(d, "secret_access_key", "secret_access_key_wo"))
+	}
+	if d.HasChange("instance_connection_name") {
+		v := d.Get("instance_connection_name").(string)
+		input.InstanceConnectionName = &v
+	}
+	if d.HasChange("service_account_key") || d.HasChange("service_account_key_wo") || d.HasChange("service_account_key_wo_version") || credutil.ResendSecret(d, "service_account_key", "service_account_key_wo") {
+		input.ServiceAccountKey = client.NewNullableString(writeonly.GetString(d, "service_account_key", "service_account_key_wo"))
+	}
+	if d.HasChange("tenant_id") {
+		v := d.Get("tenant_id").(string)
+		input.TenantID = &v
+	}
+	if d.HasChange("client_id") {
+		input.ClientID = client.NewNullableString(d.Get("client_id").(string))
+	}
+	if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") || credutil.ResendSecret(d, "client_secret", "client_secret_wo") {
+		input.ClientSecret = client.NewNullableString(writeonly.GetString(d, "client_secret", "client_secret_wo"))
+	}
 
 	_, err := client.UpdatePostgresAccessCredential(ctx, c, id, input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -10,7 +10,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/access_credential_check"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/access_credential_rotation"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/access_policy"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/anthropic_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/anthropic_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/apigee_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/apigee_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/artifactory_integration"
@@ -20,12 +24,19 @@ import (
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/aws_wif_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/azure_app_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/azure_app_access_privilege"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/azure_openai_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/azure_openai_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/azure_wif_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/bedrock_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/bitbucket_integration"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/cassandra_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/cassandra_access_privilege"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/clickhouse_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/clickhouse_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/confluence_integration"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/datadog_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/datadog_access_privilege"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/delivery_template_preview"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/deployment"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/elasticsearch_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/elasticsearch_access_privilege"
@@ -49,12 +60,16 @@ import (
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mongodb_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mongodb_atlas_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mongodb_atlas_access_privilege"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mssql_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mssql_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mysql_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/mysql_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/notification_channel"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/notification_configuration"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/openai_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/openai_access_privilege"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/oracle_access_credential"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/oracle_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/plaintext_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/postgres_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/postgres_access_privilege"
@@ -65,6 +80,7 @@ import (
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/salesforce_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/salesforce_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/secret_store"
+	"github.com/hushsecurity/terraform-provider-hush/internal/provider/secret_store_migration"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/sendgrid_access_credential"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/sendgrid_access_privilege"
 	"github.com/hushsecurity/terraform-provider-hush/internal/provider/snowflake_access_credential"
@@ -107,70 +123,85 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"hush_deployment":                       deployment.Resource(),
-				"hush_notification_channel":             notification_channel.Resource(),
-				"hush_notification_configuration":       notification_configuration.Resource(),
-				"hush_plaintext_access_credential":      plaintext_access_credential.Resource(),
-				"hush_kv_access_credential":             kv_access_credential.Resource(),
-				"hush_access_policy":                    access_policy.Resource(),
-				"hush_postgres_access_credential":       postgres_access_credential.Resource(),
-				"hush_postgres_access_privilege":        postgres_access_privilege.Resource(),
-				"hush_mongodb_access_credential":        mongodb_access_credential.Resource(),
-				"hush_mongodb_access_privilege":         mongodb_access_privilege.Resource(),
-				"hush_mongodb_atlas_access_credential":  mongodb_atlas_access_credential.Resource(),
-				"hush_mongodb_atlas_access_privilege":   mongodb_atlas_access_privilege.Resource(),
-				"hush_mysql_access_credential":          mysql_access_credential.Resource(),
-				"hush_mysql_access_privilege":           mysql_access_privilege.Resource(),
-				"hush_openai_access_credential":         openai_access_credential.Resource(),
-				"hush_openai_access_privilege":          openai_access_privilege.Resource(),
-				"hush_mariadb_access_credential":        mariadb_access_credential.Resource(),
-				"hush_gemini_access_credential":         gemini_access_credential.Resource(),
-				"hush_grok_access_credential":           grok_access_credential.Resource(),
-				"hush_grok_access_privilege":            grok_access_privilege.Resource(),
-				"hush_redis_access_credential":          redis_access_credential.Resource(),
-				"hush_redis_access_privilege":           redis_access_privilege.Resource(),
-				"hush_snowflake_access_credential":      snowflake_access_credential.Resource(),
-				"hush_snowflake_access_privilege":       snowflake_access_privilege.Resource(),
-				"hush_temporal_cloud_access_credential": temporal_cloud_access_credential.Resource(),
-				"hush_temporal_cloud_access_privilege":  temporal_cloud_access_privilege.Resource(),
-				"hush_bedrock_access_credential":        bedrock_access_credential.Resource(),
-				"hush_apigee_access_credential":         apigee_access_credential.Resource(),
-				"hush_apigee_access_privilege":          apigee_access_privilege.Resource(),
-				"hush_elasticsearch_access_credential":  elasticsearch_access_credential.Resource(),
-				"hush_elasticsearch_access_privilege":   elasticsearch_access_privilege.Resource(),
-				"hush_rabbitmq_access_credential":       rabbitmq_access_credential.Resource(),
-				"hush_rabbitmq_access_privilege":        rabbitmq_access_privilege.Resource(),
-				"hush_gcp_sa_access_credential":         gcp_sa_access_credential.Resource(),
-				"hush_gcp_sa_access_privilege":          gcp_sa_access_privilege.Resource(),
-				"hush_azure_app_access_credential":      azure_app_access_credential.Resource(),
-				"hush_azure_app_access_privilege":       azure_app_access_privilege.Resource(),
-				"hush_aws_access_key_access_credential": aws_access_key_access_credential.Resource(),
-				"hush_aws_access_key_access_privilege":  aws_access_key_access_privilege.Resource(),
-				"hush_twilio_access_credential":         twilio_access_credential.Resource(),
-				"hush_twilio_access_privilege":          twilio_access_privilege.Resource(),
-				"hush_aws_wif_access_credential":        aws_wif_access_credential.Resource(),
-				"hush_azure_wif_access_credential":      azure_wif_access_credential.Resource(),
-				"hush_gcp_wif_access_credential":        gcp_wif_access_credential.Resource(),
-				"hush_gitlab_access_credential":         gitlab_access_credential.Resource(),
-				"hush_gitlab_access_privilege":          gitlab_access_privilege.Resource(),
-				"hush_gitlab_integration":               gitlab_integration.Resource(),
-				"hush_confluence_integration":           confluence_integration.Resource(),
-				"hush_jira_integration":                 jira_integration.Resource(),
-				"hush_gcp_integration":                  gcp_integration.Resource(),
-				"hush_aws_integration":                  aws_integration.Resource(),
-				"hush_bitbucket_integration":            bitbucket_integration.Resource(),
-				"hush_infisical_integration":            infisical_integration.Resource(),
-				"hush_artifactory_integration":          artifactory_integration.Resource(),
-				"hush_datadog_access_credential":        datadog_access_credential.Resource(),
-				"hush_datadog_access_privilege":         datadog_access_privilege.Resource(),
-				"hush_salesforce_access_credential":     salesforce_access_credential.Resource(),
-				"hush_salesforce_access_privilege":      salesforce_access_privilege.Resource(),
-				"hush_sendgrid_access_credential":       sendgrid_access_credential.Resource(),
-				"hush_sendgrid_access_privilege":        sendgrid_access_privilege.Resource(),
-				"hush_sonatype_integration":             sonatype_integration.Resource(),
-				"hush_secret_store":                     secret_store.Resource(),
-				"hush_kafka_access_credential":          kafka_access_credential.Resource(),
-				"hush_kafka_access_privilege":           kafka_access_privilege.Resource(),
+				"hush_deployment":                          deployment.Resource(),
+				"hush_notification_channel":                notification_channel.Resource(),
+				"hush_notification_configuration":          notification_configuration.Resource(),
+				"hush_plaintext_access_credential":         plaintext_access_credential.Resource(),
+				"hush_kv_access_credential":                kv_access_credential.Resource(),
+				"hush_access_policy":                       access_policy.Resource(),
+				"hush_access_policy_deployment_attachment": access_policy.DeploymentAttachmentResource(),
+				"hush_access_credential_rotation":          access_credential_rotation.Resource(),
+				"hush_postgres_access_credential":          postgres_access_credential.Resource(),
+				"hush_postgres_access_privilege":           postgres_access_privilege.Resource(),
+				"hush_mongodb_access_credential":           mongodb_access_credential.Resource(),
+				"hush_mongodb_access_privilege":            mongodb_access_privilege.Resource(),
+				"hush_mongodb_atlas_access_credential":     mongodb_atlas_access_credential.Resource(),
+				"hush_mongodb_atlas_access_privilege":      mongodb_atlas_access_privilege.Resource(),
+				"hush_mysql_access_credential":             mysql_access_credential.Resource(),
+				"hush_mysql_access_privilege":              mysql_access_privilege.Resource(),
+				"hush_openai_access_credential":            openai_access_credential.Resource(),
+				"hush_openai_access_privilege":             openai_access_privilege.Resource(),
+				"hush_mariadb_access_credential":           mariadb_access_credential.Resource(),
+				"hush_mssql_access_credential":             mssql_access_credential.Resource(),
+				"hush_mssql_access_privilege":              mssql_access_privilege.Resource(),
+				"hush_oracle_access_credential":            oracle_access_credential.Resource(),
+				"hush_oracle_access_privilege":             oracle_access_privilege.Resource(),
+				"hush_gemini_access_credential":            gemini_access_credential.Resource(),
+				"hush_grok_access_credential":              grok_access_credential.Resource(),
+				"hush_grok_access_privilege":               grok_access_privilege.Resource(),
+				"hush_redis_access_credential":             redis_access_credential.Resource(),
+				"hush_redis_access_privilege":              redis_access_privilege.Resource(),
+				"hush_snowflake_access_credential":         snowflake_access_credential.Resource(),
+				"hush_snowflake_access_privilege":          snowflake_access_privilege.Resource(),
+				"hush_temporal_cloud_access_credential":    temporal_cloud_access_credential.Resource(),
+				"hush_temporal_cloud_access_privilege":     temporal_cloud_access_privilege.Resource(),
+				"hush_bedrock_access_credential":           bedrock_access_credential.Resource(),
+				"hush_apigee_access_credential":            apigee_access_credential.Resource(),
+				"hush_apigee_access_privilege":             apigee_access_privilege.Resource(),
+				"hush_elasticsearch_access_credential":     elasticsearch_access_credential.Resource(),
+				"hush_elasticsearch_access_privilege":      elasticsearch_access_privilege.Resource(),
+				"hush_rabbitmq_access_credential":          rabbitmq_access_credential.Resource(),
+				"hush_rabbitmq_access_privilege":           rabbitmq_access_privilege.Resource(),
+				"hush_gcp_sa_access_credential":            gcp_sa_access_credential.Resource(),
+				"hush_gcp_sa_access_privilege":             gcp_sa_access_privilege.Resource(),
+				"hush_azure_app_access_credential":         azure_app_access_credential.Resource(),
+				"hush_azure_app_access_privilege":          azure_app_access_privilege.Resource(),
+				"hush_aws_access_key_access_credential":    aws_access_key_access_credential.Resource(),
+				"hush_aws_access_key_access_privilege":     aws_access_key_access_privilege.Resource(),
+				"hush_twilio_access_credential":            twilio_access_credential.Resource(),
+				"hush_twilio_access_privilege":             twilio_access_privilege.Resource(),
+				"hush_aws_wif_access_credential":           aws_wif_access_credential.Resource(),
+				"hush_azure_wif_access_credential":         azure_wif_access_credential.Resource(),
+				"hush_gcp_wif_access_credential":           gcp_wif_access_credential.Resource(),
+				"hush_gitlab_access_credential":            gitlab_access_credential.Resource(),
+				"hush_gitlab_access_privilege":             gitlab_access_privilege.Resource(),
+				"hush_gitlab_integration":                  gitlab_integration.Resource(),
+				"hush_confluence_integration":              confluence_integration.Resource(),
+				"hush_jira_integration":                    jira_integration.Resource(),
+				"hush_gcp_integration":                     gcp_integration.Resource(),
+				"hush_aws_integration":                     aws_integration.Resource(),
+				"hush_bitbucket_integration":               bitbucket_integration.Resource(),
+				"hush_infisical_integration":               infisical_integration.Resource(),
+				"hush_artifactory_integration":             artifactory_integration.Resource(),
+				"hush_datadog_access_credential":           datadog_access_credential.Resource(),
+				"hush_datadog_access_privilege":            datadog_access_privilege.Resource(),
+				"hush_salesforce_access_credential":        salesforce_access_credential.Resource(),
+				"hush_salesforce_access_privilege":         salesforce_access_privilege.Resource(),
+				"hush_sendgrid_access_credential":          sendgrid_access_credential.Resource(),
+				"hush_sendgrid_access_privilege":           sendgrid_access_privilege.Resource(),
+				"hush_sonatype_integration":                sonatype_integration.Resource(),
+				"hush_secret_store":                        secret_store.Resource(),
+				"hush_secret_store_migration":              secret_store_migration.Resource(),
+				"hush_kafka_access_credential":             kafka_access_credential.Resource(),
+				"hush_kafka_access_privilege":              kafka_access_privilege.Resource(),
+				"hush_clickhouse_access_credential":        clickhouse_access_credential.Resource(),
+				"hush_clickhouse_access_privilege":         clickhouse_access_privilege.Resource(),
+				"hush_cassandra_access_credential":         cassandra_access_credential.Resource(),
+				"hush_cassandra_access_privilege":          cassandra_access_privilege.Resource(),
+				"hush_anthropic_access_credential":         anthropic_access_credential.Resource(),
+				"hush_anthropic_access_privilege":          anthropic_access_privilege.Resource(),
+				"hush_azure_openai_access_credential":      azure_openai_access_credential.Resource(),
+				"hush_azure_openai_access_privilege":       azure_openai_access_privilege.Resource(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"hush_deployment":                       deployment.DataSource(),
@@ -179,6 +210,8 @@ func New(version string) func() *schema.Provider {
 				"hush_plaintext_access_credential":      plaintext_access_credential.DataSource(),
 				"hush_kv_access_credential":             kv_access_credential.DataSource(),
 				"hush_access_policy":                    access_policy.DataSource(),
+				"hush_access_policy_match":              access_policy.MatchDataSource(),
+				"hush_access_credential_check":          access_credential_check.DataSource(),
 				"hush_postgres_access_credential":       postgres_access_credential.DataSource(),
 				"hush_postgres_access_privilege":        postgres_access_privilege.DataSource(),
 				"hush_mongodb_access_credential":        mongodb_access_credential.DataSource(),
@@ -190,6 +223,10 @@ func New(version string) func() *schema.Provider {
 				"hush_openai_access_credential":         openai_access_credential.DataSource(),
 				"hush_openai_access_privilege":          openai_access_privilege.DataSource(),
 				"hush_mariadb_access_credential":        mariadb_access_credential.DataSource(),
+				"hush_mssql_access_credential":          mssql_access_credential.DataSource(),
+				"hush_mssql_access_privilege":           mssql_access_privilege.DataSource(),
+				"hush_oracle_access_credential":         oracle_access_credential.DataSource(),
+				"hush_oracle_access_privilege":          oracle_access_privilege.DataSource(),
 				"hush_gemini_access_credential":         gemini_access_credential.DataSource(),
 				"hush_grok_access_credential":           grok_access_credential.DataSource(),
 				"hush_grok_access_privilege":            grok_access_privilege.DataSource(),
@@ -237,6 +274,15 @@ func New(version string) func() *schema.Provider {
 				"hush_secret_store":                     secret_store.DataSource(),
 				"hush_kafka_access_credential":          kafka_access_credential.DataSource(),
 				"hush_kafka_access_privilege":           kafka_access_privilege.DataSource(),
+				"hush_clickhouse_access_credential":     clickhouse_access_credential.DataSource(),
+				"hush_clickhouse_access_privilege":      clickhouse_access_privilege.DataSource(),
+				"hush_cassandra_access_credential":      cassandra_access_credential.DataSource(),
+				"hush_cassandra_access_privilege":       cassandra_access_privilege.DataSource(),
+				"hush_anthropic_access_credential":      anthropic_access_credential.DataSource(),
+				"hush_anthropic_access_privilege":       anthropic_access_privilege.DataSource(),
+				"hush_azure_openai_access_credential":   azure_openai_access_credential.DataSource(),
+				"hush_azure_openai_access_privilege":    azure_openai_access_privilege.DataSource(),
+				"hush_delivery_template_preview":        delivery_template_preview.DataSource(),
 			},
 		}
 		p.ConfigureContextFunc = configure(version, p)
@@ -27,6 +27,8 @@ func TestProvider(t *testing.T) {
 		"hush_plaintext_access_credential",
 		"hush_kv_access_credential",
 		"hush_access_policy",
+		"hush_access_policy_deployment_attachment",
+		"hush_access_credential_rotation",
 		"hush_postgres_access_credential",
 		"hush_postgres_access_privilege",
 		"hush_mongodb_access_credential",
@@ -36,6 +38,18 @@ func TestProvider(t *testing.T) {
 		"hush_openai_access_credential",
 		"hush_openai_access_privilege",
 		"hush_mariadb_access_credential",
+		"hush_mssql_access_credential",
+		"hush_mssql_access_privilege",
+		"hush_oracle_access_credential",
+		"hush_oracle_access_privilege",
+		"hush_clickhouse_access_credential",
+		"hush_clickhouse_access_privilege",
+		"hush_cassandra_access_credential",
+		"hush_cassandra_access_privilege",
+		"hush_anthropic_access_credential",
+		"hush_anthropic_access_privilege",
+		"hush_azure_openai_access_credential",
+		"hush_azure_openai_access_privilege",
 		"hush_gemini_access_credential",
 		"hush_grok_access_credential",
 		"hush_grok_access_privilege",
@@ -60,6 +74,7 @@ func TestProvider(t *testing.T) {
 		"hush_twilio_access_privilege",
 		"hush_aws_wif_access_credential",
 		"hush_gcp_wif_access_credential",
+		"hush_secret_store_migration",
 	}
 	for _, resource := range expectedResources {
 		if _, ok := provider.ResourcesMap[resource]; !ok {
@@ -84,6 +99,18 @@ func TestProvider(t *testing.T) {
 		"hush_openai_access_credential",
 		"hush_openai_access_privilege",
 		"hush_mariadb_access_credential",
+		"hush_mssql_access_credential",
+		"hush_mssql_access_privilege",
+		"hush_oracle_access_credential",
+		"hush_oracle_access_privilege",
+		"hush_clickhouse_access_credential",
+		"hush_clickhouse_access_privilege",
+		"hush_cassandra_access_credential",
+		"hush_cassandra_access_privilege",
+		"hush_anthropic_access_credential",
+		"hush_anthropic_access_privilege",
+		"hush_azure_openai_access_credential",
+		"hush_azure_openai_access_privilege",
 		"hush_gemini_access_credential",
 		"hush_grok_access_credential",
 		"hush_grok_access_privilege",
@@ -108,6 +135,9 @@ func TestProvider(t *testing.T) {
 		"hush_twilio_access_privilege",
 		"hush_aws_wif_access_credential",
 		"hush_gcp_wif_access_credential",
+		"hush_delivery_template_preview",
+		"hush_access_policy_match",
+		"hush_access_credential_check",
 	}
 	for _, dataSource := range expectedDataSources {
 		if _, ok := provider.DataSourcesMap[dataSource]; !ok {
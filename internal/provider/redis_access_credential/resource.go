@@ -12,11 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 	"github.com/hushsecurity/terraform-provider-hush/internal/credutil"
+	"github.com/hushsecurity/terraform-provider-hush/internal/deletionprotection"
 	"github.com/hushsecurity/terraform-provider-hush/internal/writeonly"
 )
 
 func Resource() *schema.Resource {
-	return &schema.Resource{
+	return credutil.WithSecretDrift(&schema.Resource{
 		Description:   "Manage Redis dynamic access credentials in the Hush Security platform.",
 		CreateContext: resourceCreate,
 		ReadContext:   resourceRead,
@@ -27,7 +28,7 @@ func Resource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: ResourceSchema(),
-	}
+	})
 }
 
 // customizeDiff rejects deployment_ids changes after creation and enforces the
@@ -292,20 +293,22 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.SetId(credential.ID)
 
 	fields := map[string]any{
-		"name":            credential.Name,
-		"description":     credential.Description,
-		"deployment_ids":  credential.DeploymentIDs,
-		"engine":          credential.Engine,
-		"project":         credential.Project,
-		"service_name":    credential.ServiceName,
-		"tenant_id":       credential.TenantID,
-		"client_id":       credential.ClientID,
-		"subscription_id": credential.SubscriptionID,
-		"resource_group":  credential.ResourceGroup,
-		"cluster_name":    credential.ClusterName,
-		"type":            string(credential.Type),
-		"kind":            credential.Kind,
-		"secret_store_id": credential.SecretStoreID,
+		"name":               credential.Name,
+		"description":        credential.Description,
+		"deployment_ids":     credential.DeploymentIDs,
+		"engine":             credential.Engine,
+		"project":            credential.Project,
+		"service_name":       credential.ServiceName,
+		"tenant_id":          credential.TenantID,
+		"client_id":          credential.ClientID,
+		"subscription_id":    credential.SubscriptionID,
+		"resource_group":     credential.ResourceGroup,
+		"cluster_name":       credential.ClusterName,
+		"type":               string(credential.Type),
+		"kind":               credential.Kind,
+		"secret_store_id":    credential.SecretStoreID,
+		"secret_store_sync":  credutil.FlattenSecretStoreSync(credential.SecretStoreSync),
+		"secret_fingerprint": credential.SecretFingerprint,
 	}
 
 	// The redis/elasticache connection and AWS fields are unset for the aiven and
@@ -361,7 +364,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			v := d.Get("service_name").(string)
 			input.ServiceName = &v
 		}
-		if d.HasChange("token") || d.HasChange("token_wo") || d.HasChange("token_wo_version") {
+		if d.HasChange("token") || d.HasChange("token_wo") || d.HasChange("token_wo_version") || credutil.ResendSecret(d, "token", "token_wo") {
 			token := writeonly.GetString(d, "token", "token_wo")
 			input.Token = &token
 		}
@@ -373,7 +376,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		if d.HasChange("client_id") {
 			input.ClientID = client.NewNullableString(d.Get("client_id").(string))
 		}
-		if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") {
+		if d.HasChange("client_secret") || d.HasChange("client_secret_wo") || d.HasChange("client_secret_wo_version") || credutil.ResendSecret(d, "client_secret", "client_secret_wo") {
 			input.ClientSecret = client.NewNullableString(writeonly.GetString(d, "client_secret", "client_secret_wo"))
 		}
 		if d.HasChange("subscription_id") {
@@ -413,7 +416,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			v := d.Get("tls_ca").(string)
 			input.TLSCA = &v
 		}
-		if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") {
+		if d.HasChange("password") || d.HasChange("password_wo") || d.HasChange("password_wo_version") || credutil.ResendSecret(d, "password", "password_wo") {
 			password := writeonly.GetString(d, "password", "password_wo")
 			input.Password = &password
 		}
@@ -433,7 +436,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			v := d.Get("access_key_id").(string)
 			input.AccessKeyID = &v
 		}
-		if d.HasChange("secret_access_key") || d.HasChange("secret_access_key_wo") || d.HasChange("secret_access_key_wo_version") {
+		if d.HasChange("secret_access_key") || d.HasChange("secret_access_key_wo") || d.HasChange("secret_access_key_wo_version") || credutil.ResendSecret(d, "secret_access_key", "secret_access_key_wo") {
 			secret := writeonly.GetString(d, "secret_access_key", "secret_access_key_wo")
 			input.SecretAccessKey = &secret
 		}
@@ -444,10 +447,18 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 
+	if err := credutil.WaitForSecretStoreMigration(ctx, c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRead(ctx, d, meta)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := deletionprotection.Check(d, "access credential"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	c := meta.(*client.Client)
 	id := d.Id()
 
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+// Write-only secrets are never read back, so a credential holding one cannot
+// see the secret change outside Terraform unless it compares fingerprints.
+// Every such credential resource is wrapped with credutil.WithSecretDrift.
+func TestWriteOnlySecretDriftDetection(t *testing.T) {
+	for name, res := range New("test")().ResourcesMap {
+		if !strings.HasSuffix(name, "_access_credential") {
+			continue
+		}
+		hasWriteOnly := false
+		for _, s := range res.Schema {
+			hasWriteOnly = hasWriteOnly || s.WriteOnly
+		}
+		if !hasWriteOnly {
+			continue
+		}
+		if _, ok := res.Schema["applied_secret_fingerprint"]; !ok {
+			t.Errorf("%s: has write-only secrets but no applied_secret_fingerprint; "+
+				"wrap it with credutil.WithSecretDrift", name)
+		}
+	}
+}
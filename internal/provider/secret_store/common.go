@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,13 +27,30 @@ const (
 	projectIDDesc = "The GCP project that hosts the backend store"
 	namespaceDesc = "The Kubernetes namespace for the secrets (defaults to the access-manager install namespace when omitted)"
 
-	awsSMDesc  = "Configuration for an AWS Secrets Manager backend. Immutable: changing it forces a new secret store."
-	awsSSMDesc = "Configuration for an AWS SSM Parameter Store backend. Immutable: changing it forces a new secret store."
-	gcpSMDesc  = "Configuration for a GCP Secret Manager backend. Immutable: changing it forces a new secret store."
-	k8sDesc    = "Configuration for a Kubernetes Secrets backend. Immutable: changing it forces a new secret store."
+	vaultURLDesc                = "The URL of the Azure Key Vault, e.g. https://my-vault.vault.azure.net/"
+	tenantIDDesc                = "The Microsoft Entra tenant ID that owns the key vault"
+	managedIdentityClientIDDesc = "The client ID of a user-assigned managed identity to access the key vault with (optional). When omitted the access-manager's default Azure credentials are used"
+
+	addressDesc        = "The address of the Vault server, e.g. https://vault.example.com:8200"
+	mountDesc          = "The path of the KV version 2 secrets engine the secrets are written to"
+	vaultNamespaceDesc = "The Vault Enterprise namespace (optional)"
+	authMethodDesc     = "How the access-manager logs in to Vault: `kubernetes` or `approle`"
+	authMountDesc      = "The path the auth method is mounted at (defaults to the auth method's name)"
+	roleDesc           = "The Vault role to log in as. Required for the `kubernetes` auth method"
+	roleIDDesc         = "The AppRole role ID. Required for the `approle` auth method"
+	secretIDDesc       = "The AppRole secret ID. Required for the `approle` auth method, unless `secret_id_wo` is set. Vault secret IDs are never read back, so a change made outside Terraform is not detected"
+	secretIDWODesc     = "The AppRole secret ID (write-only). This is a write-only attribute that is more secure than `secret_id` because Terraform will not store this value in the state file. It is only sent when `secret_id_wo_version` changes"
+	secretIDWOVerDesc  = "Used to trigger updates for `secret_id_wo`. This value should be changed when the secret ID changes. Can be any value (e.g., a timestamp, version number, or hash)."
+
+	awsSMDesc   = "Configuration for an AWS Secrets Manager backend. `kms_key_id` is updated in place; changing `prefix` or `region` moves the store."
+	awsSSMDesc  = "Configuration for an AWS SSM Parameter Store backend. `kms_key_id` is updated in place; changing `prefix` or `region` moves the store."
+	gcpSMDesc   = "Configuration for a GCP Secret Manager backend. Changing `prefix` or `project_id` moves the store."
+	k8sDesc     = "Configuration for a Kubernetes Secrets backend. `namespace` is updated in place; changing `prefix` moves the store."
+	azureKVDesc = "Configuration for an Azure Key Vault backend. `managed_identity_client_id` is updated in place; changing `prefix`, `vault_url` or `tenant_id` moves the store."
+	vaultDesc   = "Configuration for a HashiCorp Vault backend, using a KV version 2 secrets engine. The auth settings are updated in place; changing `prefix`, `address`, `mount` or `namespace` moves the store."
 )
 
-var configBlockNames = []string{"aws_sm", "aws_ssm", "gcp_sm", "k8s_secrets"}
+var configBlockNames = []string{"aws_sm", "aws_ssm", "gcp_sm", "k8s_secrets", "azure_kv", "vault"}
 
 var prefixValidation = validation.StringMatch(
 	regexp.MustCompile(`^[a-z][a-z0-9]{0,9}$`),
@@ -73,6 +91,8 @@ func SecretStoreResourceSchema() map[string]*schema.Schema {
 	s["aws_ssm"] = resourceConfigBlock(awsSSMDesc, awsConfigResource())
 	s["gcp_sm"] = resourceConfigBlock(gcpSMDesc, gcpConfigResource())
 	s["k8s_secrets"] = resourceConfigBlock(k8sDesc, k8sConfigResource())
+	s["azure_kv"] = resourceConfigBlock(azureKVDesc, azureKVConfigResource())
+	s["vault"] = resourceConfigBlock(vaultDesc, vaultConfigResource())
 
 	return s
 }
@@ -118,6 +138,8 @@ func SecretStoreDataSourceSchema() map[string]*schema.Schema {
 		"aws_ssm":     dataSourceConfigBlock(awsSSMDesc, awsConfigDataSource()),
 		"gcp_sm":      dataSourceConfigBlock(gcpSMDesc, gcpConfigDataSource()),
 		"k8s_secrets": dataSourceConfigBlock(k8sDesc, k8sConfigDataSource()),
+		"azure_kv":    dataSourceConfigBlock(azureKVDesc, azureKVConfigDataSource()),
+		"vault":       dataSourceConfigBlock(vaultDesc, vaultConfigDataSource()),
 	}
 }
 
@@ -126,7 +148,6 @@ func resourceConfigBlock(description string, elem *schema.Resource) *schema.Sche
 		Description:  description,
 		Type:         schema.TypeList,
 		Optional:     true,
-		ForceNew:     true,
 		MaxItems:     1,
 		Elem:         elem,
 		ExactlyOneOf: configBlockNames,
@@ -149,21 +170,18 @@ func awsConfigResource() *schema.Resource {
 				Description:  prefixDesc,
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: prefixValidation,
 			},
 			"region": {
 				Description:  regionDesc,
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"kms_key_id": {
 				Description: kmsKeyIDDesc,
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 			},
 		},
 	}
@@ -176,14 +194,12 @@ func gcpConfigResource() *schema.Resource {
 				Description:  prefixDesc,
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: prefixValidation,
 			},
 			"project_id": {
 				Description:  projectIDDesc,
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
@@ -197,19 +213,197 @@ func k8sConfigResource() *schema.Resource {
 				Description:  prefixDesc,
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: prefixValidation,
 			},
 			"namespace": {
 				Description: namespaceDesc,
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 			},
 		},
 	}
 }
 
+func azureKVConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Description:  prefixDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: prefixValidation,
+			},
+			"vault_url": {
+				Description:  vaultURLDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"tenant_id": {
+				Description:  tenantIDDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"managed_identity_client_id": {
+				Description:  managedIdentityClientIDDesc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func vaultConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Description:  prefixDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: prefixValidation,
+			},
+			"address": {
+				Description:  addressDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"mount": {
+				Description:  mountDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"namespace": {
+				Description: vaultNamespaceDesc,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"auth_method": {
+				Description:  authMethodDesc,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{client.VaultAuthMethodKubernetes, client.VaultAuthMethodAppRole}, false),
+			},
+			"auth_mount": {
+				Description: authMountDesc,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: roleDesc,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role_id": {
+				Description: roleIDDesc,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"secret_id": {
+				Description:   secretIDDesc,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"vault.0.secret_id_wo"},
+			},
+			"secret_id_wo": {
+				Description:   secretIDWODesc,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"vault.0.secret_id"},
+				RequiredWith:  []string{"vault.0.secret_id_wo_version"},
+			},
+			"secret_id_wo_version": {
+				Description:  secretIDWOVerDesc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"vault.0.secret_id_wo"},
+			},
+		},
+	}
+}
+
+// rawConfigGetter is implemented by both *schema.ResourceData and
+// *schema.ResourceDiff.
+type rawConfigGetter interface {
+	GetRawConfig() cty.Value
+}
+
+// rawVaultAttr returns attr of the vault block from raw config, where the
+// write-only secret_id_wo is kept, or a null value when the block is not set.
+func rawVaultAttr(d rawConfigGetter, attr string) cty.Value {
+	rc := d.GetRawConfig()
+	if rc.IsNull() || !rc.IsKnown() {
+		return cty.NullVal(cty.String)
+	}
+	blocks := rc.GetAttr("vault")
+	if blocks.IsNull() || !blocks.IsKnown() || blocks.LengthInt() == 0 {
+		return cty.NullVal(cty.String)
+	}
+	block := blocks.Index(cty.NumberIntVal(0))
+	if block.IsNull() || !block.IsKnown() {
+		return cty.NullVal(cty.String)
+	}
+	return block.GetAttr(attr)
+}
+
+// vaultSecretID returns the configured AppRole secret ID, from secret_id or
+// the write-only secret_id_wo.
+func vaultSecretID(d *schema.ResourceData) string {
+	if v := d.Get("vault.0.secret_id").(string); v != "" {
+		return v
+	}
+	if v := rawVaultAttr(d, "secret_id_wo"); !v.IsNull() && v.IsKnown() {
+		return v.AsString()
+	}
+	return ""
+}
+
+// validateVaultAuth checks at plan time that the vault block carries the
+// settings its auth method needs, and none belonging to the other method. A
+// setting only known at apply time skips the check, which the API repeats.
+func validateVaultAuth(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	blocks := d.Get("vault").([]any)
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	for _, attr := range []string{"auth_method", "role", "role_id", "secret_id"} {
+		if !d.NewValueKnown("vault.0." + attr) {
+			return nil
+		}
+	}
+	secretIDWO := rawVaultAttr(d, "secret_id_wo")
+	if !secretIDWO.IsKnown() {
+		return nil
+	}
+	block := blocks[0].(map[string]any)
+	set := func(attr string) bool { return block[attr].(string) != "" }
+	secretIDSet := set("secret_id") || (!secretIDWO.IsNull() && secretIDWO.AsString() != "")
+
+	switch block["auth_method"].(string) {
+	case client.VaultAuthMethodKubernetes:
+		if !set("role") {
+			return fmt.Errorf("vault: role is required for the kubernetes auth method")
+		}
+		if set("role_id") || secretIDSet {
+			return fmt.Errorf("vault: role_id and secret_id are only valid for the approle auth method")
+		}
+	case client.VaultAuthMethodAppRole:
+		if !set("role_id") || !secretIDSet {
+			return fmt.Errorf("vault: role_id and secret_id are required for the approle auth method (secret_id may be set as secret_id_wo)")
+		}
+		if set("role") {
+			return fmt.Errorf("vault: role is only valid for the kubernetes auth method")
+		}
+	}
+	return nil
+}
+
 func awsConfigDataSource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -238,6 +432,32 @@ func k8sConfigDataSource() *schema.Resource {
 	}
 }
 
+func azureKVConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix":                     {Description: prefixDesc, Type: schema.TypeString, Computed: true},
+			"vault_url":                  {Description: vaultURLDesc, Type: schema.TypeString, Computed: true},
+			"tenant_id":                  {Description: tenantIDDesc, Type: schema.TypeString, Computed: true},
+			"managed_identity_client_id": {Description: managedIdentityClientIDDesc, Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func vaultConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix":      {Description: prefixDesc, Type: schema.TypeString, Computed: true},
+			"address":     {Description: addressDesc, Type: schema.TypeString, Computed: true},
+			"mount":       {Description: mountDesc, Type: schema.TypeString, Computed: true},
+			"namespace":   {Description: vaultNamespaceDesc, Type: schema.TypeString, Computed: true},
+			"auth_method": {Description: authMethodDesc, Type: schema.TypeString, Computed: true},
+			"auth_mount":  {Description: authMountDesc, Type: schema.TypeString, Computed: true},
+			"role":        {Description: roleDesc, Type: schema.TypeString, Computed: true},
+			"role_id":     {Description: roleIDDesc, Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
 func secretStoreRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	c := m.(*client.Client)
 
@@ -313,6 +533,23 @@ func setConfigBlocks(d *schema.ResourceData, config *client.SecretStoreConfig) e
 		block["project_id"] = config.ProjectID
 	case client.SecretStoreKindK8sSecrets:
 		block["namespace"] = config.Namespace
+	case client.SecretStoreKindAzureKV:
+		block["vault_url"] = config.VaultURL
+		block["tenant_id"] = config.TenantID
+		block["managed_identity_client_id"] = config.ManagedIdentityClientID
+	case client.SecretStoreKindVault:
+		block["address"] = config.Address
+		block["mount"] = config.Mount
+		block["namespace"] = config.Namespace
+		block["auth_method"] = config.AuthMethod
+		block["auth_mount"] = config.AuthMount
+		block["role"] = config.Role
+		block["role_id"] = config.RoleID
+		// The API never returns the AppRole secret ID, so keep the configured
+		// one. The data source has no secret_id and ignores it.
+		if v, ok := d.Get("vault.0.secret_id").(string); ok {
+			block["secret_id"] = v
+		}
 	default:
 		return fmt.Errorf("unknown secret store kind: %s", config.Kind)
 	}
@@ -0,0 +1,258 @@
+package secret_store
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+// mutableConfigFields lists, for each config block, the fields the API
+// updates in place. The other fields say where the backend keeps secrets, so
+// changing one moves the store (relocateStore), and changing the kind
+// replaces it.
+var mutableConfigFields = map[string][]string{
+	client.SecretStoreKindAWSSM:      {"kms_key_id"},
+	client.SecretStoreKindAWSSSM:     {"kms_key_id"},
+	client.SecretStoreKindGCPSM:      {},
+	client.SecretStoreKindK8sSecrets: {"namespace"},
+	client.SecretStoreKindAzureKV:    {"managed_identity_client_id"},
+	client.SecretStoreKindVault:      {"auth_method", "auth_mount", "role", "role_id", "secret_id", "secret_id_wo", "secret_id_wo_version"},
+}
+
+// changeGetter is implemented by both *schema.ResourceData and
+// *schema.ResourceDiff.
+type changeGetter interface {
+	Id() string
+	GetChange(key string) (any, any)
+}
+
+// configBlock returns the config block in list, or nil when it is not set.
+func configBlock(list any) map[string]any {
+	blocks, _ := list.([]any)
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	return blocks[0].(map[string]any)
+}
+
+// configKinds returns the kind of the config before and after the change.
+func configKinds(d changeGetter) (oldKind, newKind string) {
+	for _, name := range configBlockNames {
+		o, n := d.GetChange(name)
+		if configBlock(o) != nil {
+			oldKind = name
+		}
+		if configBlock(n) != nil {
+			newKind = name
+		}
+	}
+	return oldKind, newKind
+}
+
+// storeRelocates reports whether an existing store keeps its kind but changes
+// a config field the API cannot update in place.
+func storeRelocates(d changeGetter) bool {
+	if d.Id() == "" {
+		return false
+	}
+	oldKind, newKind := configKinds(d)
+	if oldKind == "" || oldKind != newKind {
+		return false
+	}
+	o, n := d.GetChange(newKind)
+	oldBlock, newBlock := configBlock(o), configBlock(n)
+	for field, value := range newBlock {
+		if !slices.Contains(mutableConfigFields[newKind], field) && oldBlock[field] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// planConfigChange replaces the store when its kind changes, and marks the id
+// unknown when a config change moves the store, since it moves to a new store.
+func planConfigChange(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if oldKind, newKind := configKinds(d); oldKind != newKind {
+		for _, name := range []string{oldKind, newKind} {
+			if name == "" {
+				continue
+			}
+			if err := d.ForceNew(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if storeRelocates(d) {
+		for _, attr := range []string{"id", "status", "status_detail"} {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expandConfigUpdate returns the in-place update for the changed mutable
+// fields of the store's config block, or nil when none changed.
+func expandConfigUpdate(d *schema.ResourceData) *client.SecretStoreConfigUpdate {
+	_, kind := configKinds(d)
+	if kind == "" {
+		return nil
+	}
+	changed := func(field string) bool {
+		return slices.Contains(mutableConfigFields[kind], field) && d.HasChange(kind+".0."+field)
+	}
+	get := func(field string) string {
+		return d.Get(kind + ".0." + field).(string)
+	}
+
+	update := &client.SecretStoreConfigUpdate{Kind: kind}
+	hasChanges := false
+	if changed("kms_key_id") {
+		update.KmsKeyID = client.NewNullableString(get("kms_key_id"))
+		hasChanges = true
+	}
+	if changed("namespace") {
+		update.Namespace = client.NewNullableString(get("namespace"))
+		hasChanges = true
+	}
+	if changed("managed_identity_client_id") {
+		update.ManagedIdentityClientID = client.NewNullableString(get("managed_identity_client_id"))
+		hasChanges = true
+	}
+	if changed("auth_method") {
+		method := get("auth_method")
+		update.AuthMethod = &method
+		hasChanges = true
+	}
+	if changed("auth_mount") {
+		update.AuthMount = client.NewNullableString(get("auth_mount"))
+		hasChanges = true
+	}
+	if changed("role") {
+		update.Role = client.NewNullableString(get("role"))
+		hasChanges = true
+	}
+	if changed("role_id") {
+		update.RoleID = client.NewNullableString(get("role_id"))
+		hasChanges = true
+	}
+	// secret_id_wo is not in state, so it is only sent when its version changes.
+	if changed("secret_id") || changed("secret_id_wo_version") {
+		update.SecretID = client.NewNullableString(vaultSecretID(d))
+		hasChanges = true
+	}
+
+	if !hasChanges {
+		return nil
+	}
+	return update
+}
+
+// relocateStore moves the store to a location the API cannot update in place.
+// It creates a store with the new configuration, moves every credential to it
+// with a secret store migration, and deletes the old store. From the moment
+// the new store exists, state follows it: if the migration fails the old
+// store is kept with the credentials that could not be moved, and is no
+// longer managed by Terraform, so it must be emptied and deleted by hand.
+//
+// Credentials whose secret_store_id references this resource follow the new
+// store on their next plan. The others, set to the old ID literally or
+// managed by another configuration, would try to move back to a store that
+// no longer exists, so every moved credential is listed in a warning.
+func relocateStore(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	c := m.(*client.Client)
+	oldID := d.Id()
+
+	config, err := expandConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldDeploymentIDs, _ := d.GetChange("deployment_ids")
+	moved, err := storeCredentialIDs(ctx, c, oldID, expandStringList(oldDeploymentIDs.([]any)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	store, err := client.CreateSecretStore(ctx, c, &client.CreateSecretStoreInput{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DeploymentIDs: expandStringList(d.Get("deployment_ids").([]any)),
+		Config:        *config,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create the secret store replacing '%s': %w", oldID, err))
+	}
+	d.SetId(store.ID)
+
+	migration, err := client.CreateSecretStoreMigration(ctx, c, &client.CreateSecretStoreMigrationInput{
+		SourceSecretStoreID: oldID,
+		TargetSecretStoreID: store.ID,
+	})
+	if err == nil {
+		err = client.WaitForSecretStoreMigrationComplete(ctx, c, migration.ID)
+	}
+	if err != nil {
+		diags := secretStoreRead(ctx, d, m)
+		return append(diags, diag.Errorf(
+			"secret store '%s' was replaced by '%s', but its credentials could not all be moved: %s. "+
+				"The old store was kept but is no longer managed by Terraform; move the remaining credentials "+
+				"with hush_secret_store_migration and delete it",
+			oldID, store.ID, err)...)
+	}
+
+	var diags diag.Diagnostics
+	if len(moved) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Credentials moved from secret store '%s' to '%s'", oldID, store.ID),
+			Detail: fmt.Sprintf("These access credentials now use secret store '%s': %s. "+
+				"Those whose secret_store_id references this hush_secret_store follow it. "+
+				"Update any that set secret_store_id to '%s' directly or are managed by another configuration, "+
+				"or their next apply tries to move them back to the deleted store.",
+				store.ID, strings.Join(moved, ", "), oldID),
+		})
+	}
+
+	if err := client.DeleteSecretStore(ctx, c, oldID); err != nil {
+		if apiErr, ok := err.(*client.APIError); !ok || apiErr.StatusCode != http.StatusNotFound {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Secret store '%s' was replaced by '%s', but the old store could not be deleted", oldID, store.ID),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return append(diags, secretStoreRead(ctx, d, m)...)
+}
+
+// storeCredentialIDs returns the IDs of the credentials kept in the store,
+// found through the store's deployments, ordered by ID.
+func storeCredentialIDs(ctx context.Context, c *client.Client, storeID string, deploymentIDs []string) ([]string, error) {
+	var ids []string
+	for _, deploymentID := range deploymentIDs {
+		credentials, err := client.GetAccessCredentialsByDeployment(ctx, c, deploymentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the credentials of secret store '%s': %w", storeID, err)
+		}
+		for _, cred := range credentials {
+			if cred.SecretStoreID == storeID && !slices.Contains(ids, cred.ID) {
+				ids = append(ids, cred.ID)
+			}
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
@@ -0,0 +1,84 @@
+package secret_store
+
+import "testing"
+
+// fakeChange serves old and new values of the config blocks.
+type fakeChange struct {
+	id       string
+	old, new map[string]any
+}
+
+func (f fakeChange) Id() string { return f.id }
+
+func (f fakeChange) GetChange(key string) (any, any) {
+	return blockList(f.old[key]), blockList(f.new[key])
+}
+
+func blockList(block any) []any {
+	if block == nil {
+		return []any{}
+	}
+	return []any{block}
+}
+
+func TestStoreRelocates(t *testing.T) {
+	awsSM := func(prefix, region, kmsKeyID string) map[string]any {
+		return map[string]any{"aws_sm": map[string]any{"prefix": prefix, "region": region, "kms_key_id": kmsKeyID}}
+	}
+	vault := func(namespace, roleID string) map[string]any {
+		return map[string]any{"vault": map[string]any{
+			"prefix": "hush", "address": "https://vault:8200", "mount": "secret", "namespace": namespace,
+			"auth_method": "approle", "auth_mount": "", "role": "", "role_id": roleID, "secret_id": "s",
+		}}
+	}
+	vaultWO := func(version string) map[string]any {
+		return map[string]any{"vault": map[string]any{
+			"prefix": "hush", "address": "https://vault:8200", "mount": "secret", "namespace": "",
+			"auth_method": "approle", "auth_mount": "", "role": "", "role_id": "a", "secret_id": "",
+			"secret_id_wo": "", "secret_id_wo_version": version,
+		}}
+	}
+
+	tests := []struct {
+		name string
+		d    fakeChange
+		want bool
+	}{
+		{name: "create", d: fakeChange{new: awsSM("hush", "eu-west-1", "")}},
+		{name: "unchanged", d: fakeChange{id: "sst-1", old: awsSM("hush", "eu-west-1", ""), new: awsSM("hush", "eu-west-1", "")}},
+		{name: "kms key is in place", d: fakeChange{id: "sst-1", old: awsSM("hush", "eu-west-1", ""), new: awsSM("hush", "eu-west-1", "key")}},
+		{name: "region moves", d: fakeChange{id: "sst-1", old: awsSM("hush", "eu-west-1", ""), new: awsSM("hush", "us-east-1", "")}, want: true},
+		{name: "prefix moves", d: fakeChange{id: "sst-1", old: awsSM("hush", "eu-west-1", ""), new: awsSM("app", "eu-west-1", "")}, want: true},
+		{name: "vault auth is in place", d: fakeChange{id: "sst-1", old: vault("", "a"), new: vault("", "b")}},
+		{name: "vault secret_id_wo_version is in place", d: fakeChange{id: "sst-1", old: vaultWO("1"), new: vaultWO("2")}},
+		{name: "vault namespace moves", d: fakeChange{id: "sst-1", old: vault("", "a"), new: vault("team", "a")}, want: true},
+		{
+			name: "kind change replaces",
+			d: fakeChange{
+				id:  "sst-1",
+				old: awsSM("hush", "eu-west-1", ""),
+				new: map[string]any{"gcp_sm": map[string]any{"prefix": "hush", "project_id": "p"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := storeRelocates(tc.d); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestConfigKinds(t *testing.T) {
+	d := fakeChange{
+		id:  "sst-1",
+		old: map[string]any{"k8s_secrets": map[string]any{"prefix": "hush"}},
+		new: map[string]any{"azure_kv": map[string]any{"prefix": "hush"}},
+	}
+	oldKind, newKind := configKinds(d)
+	if oldKind != "k8s_secrets" || newKind != "azure_kv" {
+		t.Errorf("expected k8s_secrets -> azure_kv, got %v -> %v", oldKind, newKind)
+	}
+}
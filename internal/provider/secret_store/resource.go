@@ -6,11 +6,12 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hushsecurity/terraform-provider-hush/internal/client"
 )
 
-const resourceDescription = "Manages a Hush Security secret store, describing where the access-manager materializes secrets for a set of deployments."
+const resourceDescription = "Manages a Hush Security secret store, describing where the access-manager materializes secrets for a set of deployments. Changing the backend kind replaces the store. Fields such as a KMS key or Kubernetes namespace are updated in place. Changing where the backend keeps secrets, such as `prefix` or `region`, moves the store: the provider creates a store with the new configuration, moves every credential to it and deletes the old store, so the store gets a new ID. The moved credentials are listed in a warning: any that sets `secret_store_id` to the old ID directly, or is managed by another configuration, must be updated. If some credentials cannot be moved, the apply fails and the old store is kept with them, no longer managed by Terraform; move them with `hush_secret_store_migration` and delete it."
 
 func Resource() *schema.Resource {
 	return &schema.Resource{
@@ -20,6 +21,7 @@ func Resource() *schema.Resource {
 		ReadContext:   secretStoreRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: customdiff.All(validateVaultAuth, planConfigChange),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -55,6 +57,10 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 }
 
 func resourceUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if storeRelocates(d) {
+		return relocateStore(ctx, d, m)
+	}
+
 	c := m.(*client.Client)
 
 	input := &client.UpdateSecretStoreInput{}
@@ -75,6 +81,10 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		input.DeploymentIDs = &ids
 		hasChanges = true
 	}
+	if config := expandConfigUpdate(d); config != nil {
+		input.Config = config
+		hasChanges = true
+	}
 
 	if hasChanges {
 		if _, err := client.UpdateSecretStore(ctx, c, d.Id(), input); err != nil {
@@ -141,7 +151,32 @@ func expandConfig(d *schema.ResourceData) (*client.SecretStoreConfig, error) {
 			Namespace: block["namespace"].(string),
 		}, nil
 	}
-	return nil, fmt.Errorf("one of the config blocks (aws_sm, aws_ssm, gcp_sm, k8s_secrets) must be set")
+	if v, ok := d.GetOk("azure_kv"); ok {
+		block := v.([]any)[0].(map[string]any)
+		return &client.SecretStoreConfig{
+			Kind:                    client.SecretStoreKindAzureKV,
+			Prefix:                  block["prefix"].(string),
+			VaultURL:                block["vault_url"].(string),
+			TenantID:                block["tenant_id"].(string),
+			ManagedIdentityClientID: block["managed_identity_client_id"].(string),
+		}, nil
+	}
+	if v, ok := d.GetOk("vault"); ok {
+		block := v.([]any)[0].(map[string]any)
+		return &client.SecretStoreConfig{
+			Kind:       client.SecretStoreKindVault,
+			Prefix:     block["prefix"].(string),
+			Address:    block["address"].(string),
+			Mount:      block["mount"].(string),
+			Namespace:  block["namespace"].(string),
+			AuthMethod: block["auth_method"].(string),
+			AuthMount:  block["auth_mount"].(string),
+			Role:       block["role"].(string),
+			RoleID:     block["role_id"].(string),
+			SecretID:   vaultSecretID(d),
+		}, nil
+	}
+	return nil, fmt.Errorf("one of the config blocks (aws_sm, aws_ssm, gcp_sm, k8s_secrets, azure_kv, vault) must be set")
 }
 
 func expandStringList(items []any) []string {
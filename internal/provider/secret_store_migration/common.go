@@ -0,0 +1,79 @@
+package secret_store_migration
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sourceSecretStoreIDDesc = "The ID of the secret store to move credentials out of"
+	targetSecretStoreIDDesc = "The ID of the secret store to move credentials into"
+	triggersDesc            = "Arbitrary values that run the migration again when any of them changes, for example to move credentials saved in the source store since the last run"
+	statusDesc              = "The migration status: `pending` or `running` while credentials are moved, then `ok`, or `error` when any credential could not be moved"
+	statusDetailDesc        = "Why the migration failed, when status is `error`"
+	totalCredentialsDesc    = "The number of credentials saved in the source store when the migration started"
+	migratedCredentialsDesc = "The number of credentials moved to the target store"
+	failedCredentialIDsDesc = "The IDs of the credentials that could not be moved"
+	completedAtDesc         = "When the migration finished, as an RFC3339 timestamp"
+)
+
+var secretStoreIDRegex = regexp.MustCompile(`^sst-`)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source_secret_store_id": {
+			Description:  sourceSecretStoreIDDesc,
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(secretStoreIDRegex, "source_secret_store_id must start with 'sst-'"),
+		},
+		"target_secret_store_id": {
+			Description:  targetSecretStoreIDDesc,
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(secretStoreIDRegex, "target_secret_store_id must start with 'sst-'"),
+		},
+		"triggers": {
+			Description: triggersDesc,
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"status": {
+			Description: statusDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status_detail": {
+			Description: statusDetailDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"total_credentials": {
+			Description: totalCredentialsDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"migrated_credentials": {
+			Description: migratedCredentialsDesc,
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"failed_credential_ids": {
+			Description: failedCredentialIDsDesc,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"completed_at": {
+			Description: completedAtDesc,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package secret_store_migration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hushsecurity/terraform-provider-hush/internal/client"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Moves every access credential saved in one secret store to another. Creating the resource starts the migration and waits for it to finish, reporting how many credentials were moved; changing `triggers` runs it again. If any credential cannot be moved the apply fails and the resource is replaced on the next apply, which retries the migration. Destroying the resource does not move the credentials back. Point the credential resources' `secret_store_id` at the target store afterwards so that Terraform does not move them back.",
+		CreateContext: resourceCreate,
+		ReadContext:   resourceRead,
+		DeleteContext: resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: ResourceSchema(),
+	}
+}
+
+func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	source := d.Get("source_secret_store_id").(string)
+	target := d.Get("target_secret_store_id").(string)
+	if source == target {
+		return diag.Errorf("source_secret_store_id and target_secret_store_id must be different secret stores")
+	}
+
+	migration, err := client.CreateSecretStoreMigration(ctx, c, &client.CreateSecretStoreMigrationInput{
+		SourceSecretStoreID: source,
+		TargetSecretStoreID: target,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to start migration from secret store '%s' to '%s': %w", source, target, err))
+	}
+
+	// Record the migration before waiting, so that a failed or interrupted
+	// migration is kept in state (tainted) with its progress.
+	d.SetId(migration.ID)
+
+	waitErr := client.WaitForSecretStoreMigrationComplete(ctx, c, migration.ID)
+
+	diags := resourceRead(ctx, d, meta)
+	if waitErr != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("migration from secret store '%s' to '%s' did not complete: %w", source, target, waitErr))...)
+	}
+	return diags
+}
+
+func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	migration, err := client.GetSecretStoreMigration(ctx, c, d.Id())
+	if err != nil {
+		if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	fields := map[string]any{
+		"source_secret_store_id": migration.SourceSecretStoreID,
+		"target_secret_store_id": migration.TargetSecretStoreID,
+		"status":                 migration.Status,
+		"status_detail":          migration.StatusDetail,
+		"total_credentials":      migration.TotalCredentials,
+		"migrated_credentials":   migration.MigratedCredentials,
+		"failed_credential_ids":  migration.FailedCredentialIDs,
+		"completed_at":           migration.CompletedAt,
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceDelete only forgets the migration. Credentials that were moved stay
+// in the target store.
+func resourceDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
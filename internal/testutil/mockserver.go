@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
@@ -32,6 +33,19 @@ type HookError struct {
 // HookFunc can modify objects or return errors.
 type HookFunc func(op Operation, obj map[string]any) *HookError
 
+// ActionFunc serves an action on a stored object. It may modify obj, which
+// stays in the store, and returns the response body. body is the decoded
+// request body, nil when the request has none.
+type ActionFunc func(obj, body map[string]any) (map[string]any, *HookError)
+
+// action is an endpoint registered with OnAction.
+type action struct {
+	method   string
+	pattern  *regexp.Regexp
+	storeKey string
+	fn       ActionFunc
+}
+
 // route represents a parsed endpoint pattern.
 type route struct {
 	method   string
@@ -45,6 +59,7 @@ type MockServer struct {
 	Server   *httptest.Server
 	store    map[string]map[string]any // resourceKey -> id -> object
 	routes   []route
+	actions  []action
 	hooks    map[string]map[Operation][]HookFunc
 	fixtures *Fixtures
 	pageSize int // when > 0, list responses are paginated with this page size
@@ -99,6 +114,22 @@ func (ms *MockServer) OnOperation(resourceType string, op Operation, hook HookFu
 	ms.hooks[resourceType][op] = append(ms.hooks[resourceType][op], hook)
 }
 
+// OnAction registers a handler for an action endpoint, such as
+// "/v1/access_credentials/{id}/rotate", that acts on an object in storeKey
+// rather than creating one. The first path parameter is the object's ID; an ID
+// not in the store is a 404. Actions take precedence over fixture routes, which
+// would otherwise treat the POST as a create.
+func (ms *MockServer) OnAction(method, path, storeKey string, fn ActionFunc) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.actions = append(ms.actions, action{
+		method:   method,
+		pattern:  pathPattern(path),
+		storeKey: storeKey,
+		fn:       fn,
+	})
+}
+
 func (ms *MockServer) handler(w http.ResponseWriter, r *http.Request) {
 	// Handle OAuth token endpoint
 	if r.URL.Path == "/v1/oauth/token" && r.Method == http.MethodPost {
@@ -106,6 +137,19 @@ func (ms *MockServer) handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ms.mu.RLock()
+	actions := ms.actions
+	ms.mu.RUnlock()
+	for _, a := range actions {
+		if r.Method != a.method {
+			continue
+		}
+		if matches := a.pattern.FindStringSubmatch(r.URL.Path); matches != nil {
+			ms.handleAction(w, r, a, matches[1])
+			return
+		}
+	}
+
 	// Match against routes
 	for _, rt := range ms.routes {
 		if r.Method != rt.method {
@@ -164,6 +208,32 @@ func (ms *MockServer) handleRoute(w http.ResponseWriter, r *http.Request, rt rou
 	}
 }
 
+func (ms *MockServer) handleAction(w http.ResponseWriter, r *http.Request, a action, id string) {
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		ms.writeError(w, 400, "invalid JSON body")
+		return
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	obj, ok := ms.store[a.storeKey][id]
+	if !ok {
+		ms.writeError(w, 404, id+" not found")
+		return
+	}
+
+	resp, hookErr := a.fn(obj.(map[string]any), body)
+	if hookErr != nil {
+		ms.writeError(w, hookErr.Status, hookErr.Detail)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func (ms *MockServer) handleCreate(w http.ResponseWriter, r *http.Request, resourceKey, storeKey string, template map[string]any) {
 	var obj map[string]any
 	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
@@ -349,14 +419,29 @@ func (ms *MockServer) matchesFilters(obj map[string]any, params map[string][]str
 		if key == "cursor" || len(values) == 0 {
 			continue
 		}
-		val := fmt.Sprintf("%v", obj[key])
-		if val != values[0] {
+		if !matchesFilter(obj, key, values[0]) {
 			return false
 		}
 	}
 	return true
 }
 
+// matchesFilter compares a query parameter with the object's field of that
+// name or, when the object has none, looks for it in the plural list field:
+// ?deployment_id= matches an object whose deployment_ids contains it.
+func matchesFilter(obj map[string]any, key, want string) bool {
+	if val, ok := obj[key]; ok {
+		return fmt.Sprintf("%v", val) == want
+	}
+	list, _ := obj[key+"s"].([]any)
+	for _, item := range list {
+		if fmt.Sprintf("%v", item) == want {
+			return true
+		}
+	}
+	return false
+}
+
 func (ms *MockServer) generateID(resourceKey, uuid string) string {
 	prefix := ms.getIDPrefix(resourceKey)
 	return prefix + "-" + uuid[:8]
@@ -442,12 +527,8 @@ func parseRoutes(endpoints map[string]map[string]any) []route {
 		}
 		method, path := parts[0], parts[1]
 
-		// Convert path params like {id} to regex
-		pattern := "^" + regexp.QuoteMeta(path) + "$"
-		pattern = regexp.MustCompile(`\\{[^}]+\\}`).ReplaceAllString(pattern, "([^/]+)")
-
-		re, err := regexp.Compile(pattern)
-		if err != nil {
+		re := pathPattern(path)
+		if re == nil {
 			continue
 		}
 
@@ -466,6 +547,19 @@ func parseRoutes(endpoints map[string]map[string]any) []route {
 	return routes
 }
 
+// pathPattern converts path params like {id} to a regex matching the path, or
+// returns nil if the path does not compile.
+func pathPattern(path string) *regexp.Regexp {
+	pattern := "^" + regexp.QuoteMeta(path) + "$"
+	pattern = regexp.MustCompile(`\\{[^}]+\\}`).ReplaceAllString(pattern, "([^/]+)")
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
 // extractResourceKey extracts resource identifier from path template.
 func extractResourceKey(template string) string {
 	// Remove /v1/ prefix and parameter placeholders
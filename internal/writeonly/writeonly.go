@@ -10,20 +10,24 @@
 // IsSet in CustomizeDiff to check whether a write-only attribute is set.
 package writeonly
 
-import (
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
+import "github.com/hashicorp/go-cty/cty"
 
 // rawConfigGetter is implemented by both *schema.ResourceData and *schema.ResourceDiff.
 type rawConfigGetter interface {
 	GetRawConfig() cty.Value
 }
 
+// configGetter is implemented by both *schema.ResourceData and *schema.ResourceDiff.
+type configGetter interface {
+	rawConfigGetter
+	GetOk(key string) (any, bool)
+}
+
 // GetString returns the value of plainAttr if set, otherwise the value of the
 // write-only attribute woAttr read from raw config. Returns "" if neither
-// is set.
-func GetString(d *schema.ResourceData, plainAttr, woAttr string) string {
+// is set. Accepts a *schema.ResourceDiff too, for CustomizeDiff functions
+// that need the secret itself rather than whether it is set.
+func GetString(d configGetter, plainAttr, woAttr string) string {
 	if v, ok := d.GetOk(plainAttr); ok {
 		return v.(string)
 	}